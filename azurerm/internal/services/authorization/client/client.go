@@ -7,6 +7,7 @@ import (
 )
 
 type Client struct {
+	DenyAssignmentsClient   *authorization.DenyAssignmentsClient
 	GroupsClient            *graphrbac.GroupsClient
 	RoleAssignmentsClient   *authorization.RoleAssignmentsClient
 	RoleDefinitionsClient   *authorization.RoleDefinitionsClient
@@ -14,6 +15,9 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	denyAssignmentsClient := authorization.NewDenyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&denyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := graphrbac.NewGroupsClientWithBaseURI(o.GraphEndpoint, o.TenantID)
 	o.ConfigureClient(&groupsClient.Client, o.GraphAuthorizer)
 
@@ -27,6 +31,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&servicePrincipalsClient.Client, o.GraphAuthorizer)
 
 	return &Client{
+		DenyAssignmentsClient:   &denyAssignmentsClient,
 		GroupsClient:            &groupsClient,
 		RoleAssignmentsClient:   &roleAssignmentsClient,
 		RoleDefinitionsClient:   &roleDefinitionsClient,
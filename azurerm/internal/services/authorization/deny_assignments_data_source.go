@@ -0,0 +1,294 @@
+package authorization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	managementGroupValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/validate"
+	resourceValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	subscriptionValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmDenyAssignments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmDenyAssignmentsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.Any(
+					managementGroupValidate.ManagementGroupID,
+					subscriptionValidate.SubscriptionID,
+					resourceValidate.ResourceGroupID,
+					azure.ValidateResourceID,
+				),
+			},
+
+			"principal_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"deny_assignments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"deny_assignment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"do_not_apply_to_child_scopes": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"is_system_protected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"not_actions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"data_actions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"not_data_actions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"principals": denyAssignmentPrincipalsSchema(),
+
+						"exclude_principals": denyAssignmentPrincipalsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func denyAssignmentPrincipalsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmDenyAssignmentsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.DenyAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+
+	// without a filter the API returns the Deny Assignments at, above and below the Scope
+	filter := ""
+	if v := d.Get("principal_id").(string); v != "" {
+		filter = fmt.Sprintf("principalId eq '%s'", v)
+	}
+
+	iterator, err := client.ListForScopeComplete(ctx, scope, filter)
+	if err != nil {
+		return fmt.Errorf("listing Deny Assignments (Scope %q): %+v", scope, err)
+	}
+
+	denyAssignments := make([]authorization.DenyAssignment, 0)
+	for iterator.NotDone() {
+		denyAssignments = append(denyAssignments, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Deny Assignments (Scope %q): %+v", scope, err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("deny_assignments", flattenDenyAssignments(denyAssignments)); err != nil {
+		return fmt.Errorf("setting `deny_assignments`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDenyAssignments(input []authorization.DenyAssignment) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		denyAssignmentName := ""
+		description := ""
+		scope := ""
+		doNotApplyToChildScopes := false
+		isSystemProtected := false
+		permissions := make([]interface{}, 0)
+		principals := make([]interface{}, 0)
+		excludePrincipals := make([]interface{}, 0)
+		if props := item.DenyAssignmentProperties; props != nil {
+			if props.DenyAssignmentName != nil {
+				denyAssignmentName = *props.DenyAssignmentName
+			}
+			if props.Description != nil {
+				description = *props.Description
+			}
+			if props.Scope != nil {
+				scope = *props.Scope
+			}
+			if props.DoNotApplyToChildScopes != nil {
+				doNotApplyToChildScopes = *props.DoNotApplyToChildScopes
+			}
+			if props.IsSystemProtected != nil {
+				isSystemProtected = *props.IsSystemProtected
+			}
+
+			permissions = flattenDenyAssignmentPermissions(props.Permissions)
+			principals = flattenDenyAssignmentPrincipals(props.Principals)
+			excludePrincipals = flattenDenyAssignmentPrincipals(props.ExcludePrincipals)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                           id,
+			"name":                         name,
+			"deny_assignment_name":         denyAssignmentName,
+			"description":                  description,
+			"scope":                        scope,
+			"do_not_apply_to_child_scopes": doNotApplyToChildScopes,
+			"is_system_protected":          isSystemProtected,
+			"permissions":                  permissions,
+			"principals":                   principals,
+			"exclude_principals":           excludePrincipals,
+		})
+	}
+
+	return results
+}
+
+func flattenDenyAssignmentPermissions(input *[]authorization.DenyAssignmentPermission) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"actions":          utils.FlattenStringSlice(item.Actions),
+			"not_actions":      utils.FlattenStringSlice(item.NotActions),
+			"data_actions":     utils.FlattenStringSlice(item.DataActions),
+			"not_data_actions": utils.FlattenStringSlice(item.NotDataActions),
+		})
+	}
+
+	return results
+}
+
+func flattenDenyAssignmentPrincipals(input *[]authorization.Principal) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		principalType := ""
+		if item.Type != nil {
+			principalType = *item.Type
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":   id,
+			"type": principalType,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,68 @@
+package authorization_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type DenyAssignmentsDataSource struct{}
+
+func TestAccDenyAssignmentsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_deny_assignments", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DenyAssignmentsDataSource{}.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deny_assignments.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDenyAssignmentsDataSource_principalId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_deny_assignments", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DenyAssignmentsDataSource{}.principalId(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deny_assignments.#").Exists(),
+			),
+		},
+	})
+}
+
+func (DenyAssignmentsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_deny_assignments" "test" {
+  scope = data.azurerm_subscription.primary.id
+}
+`
+}
+
+func (DenyAssignmentsDataSource) principalId() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_deny_assignments" "test" {
+  scope        = data.azurerm_subscription.primary.id
+  principal_id = data.azurerm_client_config.current.object_id
+}
+`
+}
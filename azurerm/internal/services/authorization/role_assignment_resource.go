@@ -123,6 +123,13 @@ func resourceArmRoleAssignment() *schema.Resource {
 					"2.0",
 				}, false),
 			},
+
+			"delegated_managed_identity_resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
 		},
 	}
 }
@@ -194,6 +201,10 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("`condition` and `conditionVersion` should be both set or unset")
 	}
 
+	if v := d.Get("delegated_managed_identity_resource_id").(string); v != "" {
+		properties.RoleAssignmentProperties.DelegatedManagedIdentityResourceID = utils.String(v)
+	}
+
 	skipPrincipalCheck := d.Get("skip_service_principal_aad_check").(bool)
 	if skipPrincipalCheck {
 		properties.RoleAssignmentProperties.PrincipalType = authorization.ServicePrincipal
@@ -242,6 +253,7 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("description", props.Description)
 		d.Set("condition", props.Condition)
 		d.Set("condition_version", props.ConditionVersion)
+		d.Set("delegated_managed_identity_resource_id", props.DelegatedManagedIdentityResourceID)
 
 		// allows for import when role name is used (also if the role name changes a plan will show a diff)
 		if roleId := props.RoleDefinitionID; roleId != nil {
@@ -214,6 +214,26 @@ func TestAccRoleAssignment_condition(t *testing.T) {
 	})
 }
 
+func TestAccRoleAssignment_delegatedManagedIdentityResourceId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
+	id := uuid.New().String()
+
+	r := RoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.delegatedManagedIdentityResourceId(data, id),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delegated_managed_identity_resource_id").MatchesOtherKey(
+					check.That("azurerm_user_assigned_identity.test").Key("id"),
+				),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check"),
+	})
+}
+
 func (r RoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.RoleAssignmentID(state.ID)
 	if err != nil {
@@ -497,3 +517,31 @@ resource "azurerm_role_assignment" "test" {
 }
 `, groupId)
 }
+
+func (RoleAssignmentResource) delegatedManagedIdentityResourceId(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                                   = "%s"
+  scope                                  = azurerm_resource_group.test.id
+  role_definition_name                   = "Reader"
+  principal_id                           = azurerm_user_assigned_identity.test.principal_id
+  delegated_managed_identity_resource_id = azurerm_user_assigned_identity.test.id
+  skip_service_principal_aad_check       = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, roleAssignmentId)
+}
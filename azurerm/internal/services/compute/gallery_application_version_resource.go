@@ -0,0 +1,425 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceGalleryApplicationVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGalleryApplicationVersionCreateUpdate,
+		Read:   resourceGalleryApplicationVersionRead,
+		Update: resourceGalleryApplicationVersionCreateUpdate,
+		Delete: resourceGalleryApplicationVersionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.GalleryApplicationVersionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationVersionName,
+			},
+
+			"gallery_application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"manage_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"install": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+
+						"remove": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"media_link": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"default_configuration_link": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+
+			"target_region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+
+						"regional_replica_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+
+						// as with `azurerm_shared_image_version` the API doesn't support updating the `storage_account_type`
+						// and since this lives within a Set we're unable to use `ForceNew` / `CustomizeDiff` - so the API error is surfaced
+						"storage_account_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.StorageAccountTypePremiumLRS),
+								string(compute.StorageAccountTypeStandardLRS),
+								string(compute.StorageAccountTypeStandardZRS),
+							}, false),
+							Default: string(compute.StorageAccountTypeStandardLRS),
+						},
+					},
+				},
+			},
+
+			"enable_health_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"end_of_life_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"exclude_from_latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceGalleryApplicationVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationId, err := parse.GalleryApplicationID(d.Get("gallery_application_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewGalleryApplicationVersionID(applicationId.SubscriptionId, applicationId.ResourceGroup, applicationId.GalleryName, applicationId.ApplicationName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_gallery_application_version", id.ID())
+		}
+	}
+
+	version := compute.GalleryApplicationVersion{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		GalleryApplicationVersionProperties: &compute.GalleryApplicationVersionProperties{
+			PublishingProfile: &compute.GalleryApplicationVersionPublishingProfile{
+				EnableHealthCheck: utils.Bool(d.Get("enable_health_check").(bool)),
+				ExcludeFromLatest: utils.Bool(d.Get("exclude_from_latest").(bool)),
+				ManageActions:     expandGalleryApplicationVersionManageAction(d.Get("manage_action").([]interface{})),
+				Source:            expandGalleryApplicationVersionSource(d.Get("source").([]interface{})),
+				TargetRegions:     expandGalleryApplicationVersionTargetRegions(d.Get("target_region").(*schema.Set).List()),
+			},
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("end_of_life_date"); ok {
+		endOfLifeDate, _ := time.Parse(time.RFC3339, v.(string))
+		version.GalleryApplicationVersionProperties.PublishingProfile.EndOfLifeDate = &date.Time{
+			Time: endOfLifeDate,
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, version)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGalleryApplicationVersionRead(d, meta)
+}
+
+func resourceGalleryApplicationVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VersionName)
+	d.Set("gallery_application_id", parse.NewGalleryApplicationID(id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ApplicationName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.GalleryApplicationVersionProperties; props != nil {
+		if profile := props.PublishingProfile; profile != nil {
+			d.Set("enable_health_check", profile.EnableHealthCheck)
+			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+			endOfLifeDate := ""
+			if profile.EndOfLifeDate != nil {
+				endOfLifeDate = profile.EndOfLifeDate.Format(time.RFC3339)
+			}
+			d.Set("end_of_life_date", endOfLifeDate)
+
+			if err := d.Set("manage_action", flattenGalleryApplicationVersionManageAction(profile.ManageActions)); err != nil {
+				return fmt.Errorf("setting `manage_action`: %+v", err)
+			}
+
+			if err := d.Set("source", flattenGalleryApplicationVersionSource(profile.Source)); err != nil {
+				return fmt.Errorf("setting `source`: %+v", err)
+			}
+
+			if err := d.Set("target_region", flattenGalleryApplicationVersionTargetRegions(profile.TargetRegions)); err != nil {
+				return fmt.Errorf("setting `target_region`: %+v", err)
+			}
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceGalleryApplicationVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryApplicationVersionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GalleryApplicationVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandGalleryApplicationVersionManageAction(input []interface{}) *compute.UserArtifactManage {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	output := compute.UserArtifactManage{
+		Install: utils.String(v["install"].(string)),
+		Remove:  utils.String(v["remove"].(string)),
+	}
+
+	if update := v["update"].(string); update != "" {
+		output.Update = utils.String(update)
+	}
+
+	return &output
+}
+
+func flattenGalleryApplicationVersionManageAction(input *compute.UserArtifactManage) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	install := ""
+	if input.Install != nil {
+		install = *input.Install
+	}
+
+	remove := ""
+	if input.Remove != nil {
+		remove = *input.Remove
+	}
+
+	update := ""
+	if input.Update != nil {
+		update = *input.Update
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"install": install,
+			"remove":  remove,
+			"update":  update,
+		},
+	}
+}
+
+func expandGalleryApplicationVersionSource(input []interface{}) *compute.UserArtifactSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	output := compute.UserArtifactSource{
+		MediaLink: utils.String(v["media_link"].(string)),
+	}
+
+	if link := v["default_configuration_link"].(string); link != "" {
+		output.DefaultConfigurationLink = utils.String(link)
+	}
+
+	return &output
+}
+
+func flattenGalleryApplicationVersionSource(input *compute.UserArtifactSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	mediaLink := ""
+	if input.MediaLink != nil {
+		mediaLink = *input.MediaLink
+	}
+
+	defaultConfigurationLink := ""
+	if input.DefaultConfigurationLink != nil {
+		defaultConfigurationLink = *input.DefaultConfigurationLink
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"media_link":                 mediaLink,
+			"default_configuration_link": defaultConfigurationLink,
+		},
+	}
+}
+
+func expandGalleryApplicationVersionTargetRegions(input []interface{}) *[]compute.TargetRegion {
+	results := make([]compute.TargetRegion, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, compute.TargetRegion{
+			Name:                 utils.String(location.Normalize(v["name"].(string))),
+			RegionalReplicaCount: utils.Int32(int32(v["regional_replica_count"].(int))),
+			StorageAccountType:   compute.StorageAccountType(v["storage_account_type"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenGalleryApplicationVersionTargetRegions(input *[]compute.TargetRegion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		regionalReplicaCount := 0
+		if item.RegionalReplicaCount != nil {
+			regionalReplicaCount = int(*item.RegionalReplicaCount)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                   location.NormalizeNilable(item.Name),
+			"regional_replica_count": regionalReplicaCount,
+			"storage_account_type":   string(item.StorageAccountType),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,312 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	computeValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/base64"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func OrchestratedVirtualMachineScaleSetOSProfileSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_data": base64.OptionalSchema(false),
+
+				"linux_configuration": orchestratedVirtualMachineScaleSetLinuxConfigurationSchema(),
+
+				"windows_configuration": orchestratedVirtualMachineScaleSetWindowsConfigurationSchema(),
+			},
+		},
+	}
+}
+
+func orchestratedVirtualMachineScaleSetLinuxConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"admin_password": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Sensitive:        true,
+					DiffSuppressFunc: adminPasswordDiffSuppressFunc,
+					ValidateFunc:     validation.StringIsNotEmpty,
+				},
+
+				"admin_ssh_key": SSHKeysSchema(false),
+
+				"computer_name_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+
+					// Computed since we reuse the VMSS name if one's not specified
+					Computed: true,
+					ForceNew: true,
+
+					ValidateFunc: ValidateLinuxComputerNamePrefix,
+				},
+
+				"disable_password_authentication": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"provision_vm_agent": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+					ForceNew: true,
+				},
+
+				"secret": linuxSecretSchema(),
+			},
+		},
+	}
+}
+
+func orchestratedVirtualMachineScaleSetWindowsConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"admin_password": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Sensitive:        true,
+					DiffSuppressFunc: adminPasswordDiffSuppressFunc,
+					ValidateFunc:     validation.StringIsNotEmpty,
+				},
+
+				"computer_name_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+
+					// Computed since we reuse the VMSS name if one's not specified
+					Computed: true,
+					ForceNew: true,
+
+					ValidateFunc: ValidateWindowsComputerNamePrefix,
+				},
+
+				"enable_automatic_updates": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"provision_vm_agent": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+					ForceNew: true,
+				},
+
+				"secret": windowsSecretSchema(),
+
+				"timezone": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: computeValidate.VirtualMachineTimeZone(),
+				},
+
+				"winrm_listener": winRmListenerSchema(),
+			},
+		},
+	}
+}
+
+func ExpandOrchestratedVirtualMachineScaleSetOSProfile(input []interface{}, defaultComputerNamePrefix string) (*compute.VirtualMachineScaleSetOSProfile, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	output := compute.VirtualMachineScaleSetOSProfile{}
+
+	if customData := raw["custom_data"].(string); customData != "" {
+		output.CustomData = utils.String(customData)
+	}
+
+	if linuxRaw := raw["linux_configuration"].([]interface{}); len(linuxRaw) > 0 && linuxRaw[0] != nil {
+		linux := linuxRaw[0].(map[string]interface{})
+
+		computerNamePrefix := linux["computer_name_prefix"].(string)
+		if computerNamePrefix == "" {
+			if _, errs := ValidateLinuxComputerNamePrefix(defaultComputerNamePrefix, "computer_name_prefix"); len(errs) > 0 {
+				return nil, fmt.Errorf("unable to assume default computer name prefix %s. Please adjust the %q, or specify an explicit %q", errs[0], "name", "computer_name_prefix")
+			}
+			computerNamePrefix = defaultComputerNamePrefix
+		}
+
+		sshKeys := ExpandSSHKeys(linux["admin_ssh_key"].(*schema.Set).List())
+		disablePasswordAuthentication := linux["disable_password_authentication"].(bool)
+
+		output.AdminUsername = utils.String(linux["admin_username"].(string))
+		output.ComputerNamePrefix = utils.String(computerNamePrefix)
+		output.LinuxConfiguration = &compute.LinuxConfiguration{
+			DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+			ProvisionVMAgent:              utils.Bool(linux["provision_vm_agent"].(bool)),
+			SSH: &compute.SSHConfiguration{
+				PublicKeys: &sshKeys,
+			},
+		}
+		output.Secrets = expandLinuxSecrets(linux["secret"].([]interface{}))
+
+		if adminPassword := linux["admin_password"].(string); adminPassword != "" {
+			output.AdminPassword = utils.String(adminPassword)
+		}
+
+		// Azure API: "Authentication using either SSH or by user name and password must be enabled in Linux profile."
+		if disablePasswordAuthentication && output.AdminPassword == nil && len(sshKeys) == 0 {
+			return nil, fmt.Errorf("At least one SSH key must be specified if `disable_password_authentication` is enabled")
+		}
+	}
+
+	if windowsRaw := raw["windows_configuration"].([]interface{}); len(windowsRaw) > 0 && windowsRaw[0] != nil {
+		windows := windowsRaw[0].(map[string]interface{})
+
+		computerNamePrefix := windows["computer_name_prefix"].(string)
+		if computerNamePrefix == "" {
+			if _, errs := ValidateWindowsComputerNamePrefix(defaultComputerNamePrefix, "computer_name_prefix"); len(errs) > 0 {
+				return nil, fmt.Errorf("unable to assume default computer name prefix %s. Please adjust the %q, or specify an explicit %q", errs[0], "name", "computer_name_prefix")
+			}
+			computerNamePrefix = defaultComputerNamePrefix
+		}
+
+		output.AdminUsername = utils.String(windows["admin_username"].(string))
+		output.AdminPassword = utils.String(windows["admin_password"].(string))
+		output.ComputerNamePrefix = utils.String(computerNamePrefix)
+		output.WindowsConfiguration = &compute.WindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(windows["enable_automatic_updates"].(bool)),
+			ProvisionVMAgent:       utils.Bool(windows["provision_vm_agent"].(bool)),
+			WinRM:                  expandWinRMListener(windows["winrm_listener"].(*schema.Set).List()),
+		}
+		output.Secrets = expandWindowsSecrets(windows["secret"].([]interface{}))
+
+		if timezone := windows["timezone"].(string); timezone != "" {
+			output.WindowsConfiguration.TimeZone = utils.String(timezone)
+		}
+	}
+
+	return &output, nil
+}
+
+func FlattenOrchestratedVirtualMachineScaleSetOSProfile(input *compute.VirtualMachineScaleSetOSProfile, d *schema.ResourceData) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	// neither `admin_password` nor `custom_data` are returned from the API, so we pull these from the config
+	adminPassword := ""
+	customData := d.Get("os_profile.0.custom_data").(string)
+
+	adminUsername := ""
+	if input.AdminUsername != nil {
+		adminUsername = *input.AdminUsername
+	}
+
+	computerNamePrefix := ""
+	if input.ComputerNamePrefix != nil {
+		computerNamePrefix = *input.ComputerNamePrefix
+	}
+
+	linuxConfiguration := make([]interface{}, 0)
+	if linux := input.LinuxConfiguration; linux != nil {
+		adminPassword = d.Get("os_profile.0.linux_configuration.0.admin_password").(string)
+
+		disablePasswordAuthentication := false
+		if linux.DisablePasswordAuthentication != nil {
+			disablePasswordAuthentication = *linux.DisablePasswordAuthentication
+		}
+
+		provisionVMAgent := false
+		if linux.ProvisionVMAgent != nil {
+			provisionVMAgent = *linux.ProvisionVMAgent
+		}
+
+		sshKeys, err := FlattenSSHKeys(linux.SSH)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `admin_ssh_key`: %+v", err)
+		}
+
+		linuxConfiguration = append(linuxConfiguration, map[string]interface{}{
+			"admin_username":                  adminUsername,
+			"admin_password":                  adminPassword,
+			"admin_ssh_key":                   schema.NewSet(SSHKeySchemaHash, *sshKeys),
+			"computer_name_prefix":            computerNamePrefix,
+			"disable_password_authentication": disablePasswordAuthentication,
+			"provision_vm_agent":              provisionVMAgent,
+			"secret":                          flattenLinuxSecrets(input.Secrets),
+		})
+	}
+
+	windowsConfiguration := make([]interface{}, 0)
+	if windows := input.WindowsConfiguration; windows != nil {
+		adminPassword = d.Get("os_profile.0.windows_configuration.0.admin_password").(string)
+
+		enableAutomaticUpdates := false
+		if windows.EnableAutomaticUpdates != nil {
+			enableAutomaticUpdates = *windows.EnableAutomaticUpdates
+		}
+
+		provisionVMAgent := false
+		if windows.ProvisionVMAgent != nil {
+			provisionVMAgent = *windows.ProvisionVMAgent
+		}
+
+		timezone := ""
+		if windows.TimeZone != nil {
+			timezone = *windows.TimeZone
+		}
+
+		windowsConfiguration = append(windowsConfiguration, map[string]interface{}{
+			"admin_username":           adminUsername,
+			"admin_password":           adminPassword,
+			"computer_name_prefix":     computerNamePrefix,
+			"enable_automatic_updates": enableAutomaticUpdates,
+			"provision_vm_agent":       provisionVMAgent,
+			"secret":                   flattenWindowsSecrets(input.Secrets),
+			"timezone":                 timezone,
+			"winrm_listener":           flattenWinRMListener(windows.WinRM),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"custom_data":           customData,
+			"linux_configuration":   linuxConfiguration,
+			"windows_configuration": windowsConfiguration,
+		},
+	}, nil
+}
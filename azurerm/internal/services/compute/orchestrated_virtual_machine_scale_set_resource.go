@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
@@ -79,6 +80,55 @@ func resourceOrchestratedVirtualMachineScaleSet() *schema.Resource {
 			// the VMO mode can only be deployed into one zone for now, and its zone will also be assigned to all its VM instances
 			"zones": azure.SchemaSingleZone(),
 
+			// the fields below make up the (optional) Virtual Machine Profile, when omitted Virtual Machines
+			// have to be added to this Scale Set individually via the `virtual_machine_scale_set_id` field
+			"boot_diagnostics": bootDiagnosticsSchema(),
+
+			"data_disk": VirtualMachineScaleSetDataDiskSchema(),
+
+			"extension": VirtualMachineScaleSetExtensionsSchema(),
+
+			"extensions_time_budget": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PT1H30M",
+				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
+			},
+
+			"identity": VirtualMachineScaleSetIdentitySchema(),
+
+			"instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+				RequiredWith: []string{"sku_name"},
+			},
+
+			"network_interface": orchestratedVirtualMachineScaleSetNetworkInterfaceSchema(),
+
+			"os_disk": orchestratedVirtualMachineScaleSetOSDiskSchema(),
+
+			"os_profile": OrchestratedVirtualMachineScaleSetOSProfileSchema(),
+
+			"sku_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validate.ImageID,
+					validate.SharedImageID,
+					validate.SharedImageVersionID,
+				),
+			},
+
+			"source_image_reference": sourceImageReferenceSchema(false),
+
 			"unique_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -86,6 +136,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: orchestratedVirtualMachineScaleSetCustomizeDiff,
 	}
 }
 
@@ -114,12 +166,37 @@ func resourceOrchestratedVirtualMachineScaleSetCreateUpdate(d *schema.ResourceDa
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			OrchestrationMode:        compute.Flexible,
 			PlatformFaultDomainCount: utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
 			SinglePlacementGroup:     utils.Bool(d.Get("single_placement_group").(bool)),
 		},
 		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("identity"); ok {
+		identity, err := ExpandVirtualMachineScaleSetIdentity(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		props.Identity = identity
+	}
+
+	if v, ok := d.GetOk("sku_name"); ok {
+		props.Sku = &compute.Sku{
+			Name:     utils.String(v.(string)),
+			Capacity: utils.Int64(int64(d.Get("instances").(int))),
+
+			// doesn't appear this can be set to anything else, even Promo machines are Standard
+			Tier: utils.String("Standard"),
+		}
+	}
+
+	virtualMachineProfile, err := expandOrchestratedVirtualMachineScaleSetVMProfile(d)
+	if err != nil {
+		return err
+	}
+	props.VirtualMachineScaleSetProperties.VirtualMachineProfile = virtualMachineProfile
+
 	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
 		props.VirtualMachineScaleSetProperties.ProximityPlacementGroup = &compute.SubResource{
 			ID: utils.String(v.(string)),
@@ -182,6 +259,29 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *schema.ResourceData, meta
 		}
 		d.Set("proximity_placement_group_id", proximityPlacementGroupID)
 		d.Set("unique_id", props.UniqueID)
+
+		if err := flattenOrchestratedVirtualMachineScaleSetVMProfile(d, props.VirtualMachineProfile); err != nil {
+			return err
+		}
+	}
+
+	var skuName *string
+	var instances int
+	if resp.Sku != nil {
+		skuName = resp.Sku.Name
+		if resp.Sku.Capacity != nil {
+			instances = int(*resp.Sku.Capacity)
+		}
+	}
+	d.Set("instances", instances)
+	d.Set("sku_name", skuName)
+
+	identity, err := FlattenVirtualMachineScaleSetIdentity(resp.Identity)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
 	if err := d.Set("zones", resp.Zones); err != nil {
@@ -216,3 +316,174 @@ func resourceOrchestratedVirtualMachineScaleSetDelete(d *schema.ResourceData, me
 
 	return nil
 }
+
+func orchestratedVirtualMachineScaleSetNetworkInterfaceSchema() *schema.Schema {
+	// the Virtual Machine Profile is optional in Flexible mode - so the shared schema is reused as Optional
+	s := VirtualMachineScaleSetNetworkInterfaceSchema()
+	s.Required = false
+	s.Optional = true
+	s.RequiredWith = []string{"os_profile"}
+	return s
+}
+
+func orchestratedVirtualMachineScaleSetOSDiskSchema() *schema.Schema {
+	// the Virtual Machine Profile is optional in Flexible mode - so the shared schema is reused as Optional
+	s := VirtualMachineScaleSetOSDiskSchema()
+	s.Required = false
+	s.Optional = true
+	s.RequiredWith = []string{"os_profile"}
+	return s
+}
+
+func expandOrchestratedVirtualMachineScaleSetVMProfile(d *schema.ResourceData) (*compute.VirtualMachineScaleSetVMProfile, error) {
+	osProfileRaw := d.Get("os_profile").([]interface{})
+	if len(osProfileRaw) == 0 {
+		// when no `os_profile` is specified the Scale Set is just a container which Virtual Machines can join
+		return nil, nil
+	}
+
+	osProfile, err := ExpandOrchestratedVirtualMachineScaleSetOSProfile(osProfileRaw, d.Get("name").(string))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `os_profile`: %+v", err)
+	}
+
+	osType := compute.Linux
+	if osProfile.WindowsConfiguration != nil {
+		osType = compute.Windows
+	}
+
+	networkInterfaces, err := ExpandVirtualMachineScaleSetNetworkInterface(d.Get("network_interface").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `network_interface`: %+v", err)
+	}
+
+	dataDisks, err := ExpandVirtualMachineScaleSetDataDisk(d.Get("data_disk").([]interface{}), false)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `data_disk`: %+v", err)
+	}
+
+	sourceImageReference, err := expandSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	profile := compute.VirtualMachineScaleSetVMProfile{
+		DiagnosticsProfile: expandBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		NetworkProfile: &compute.VirtualMachineScaleSetNetworkProfile{
+			NetworkInterfaceConfigurations: networkInterfaces,
+		},
+		OsProfile: osProfile,
+		StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+			ImageReference: sourceImageReference,
+			OsDisk:         ExpandVirtualMachineScaleSetOSDisk(d.Get("os_disk").([]interface{}), osType),
+			DataDisks:      dataDisks,
+		},
+	}
+
+	if v, ok := d.GetOk("extension"); ok {
+		profile.ExtensionProfile, _, err = expandVirtualMachineScaleSetExtensions(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := d.GetOk("extensions_time_budget"); ok {
+		if profile.ExtensionProfile == nil {
+			profile.ExtensionProfile = &compute.VirtualMachineScaleSetExtensionProfile{}
+		}
+		profile.ExtensionProfile.ExtensionsTimeBudget = utils.String(v.(string))
+	}
+
+	return &profile, nil
+}
+
+// orchestratedVirtualMachineScaleSetCustomizeDiff ensures the fields making up the Virtual Machine Profile are only
+// specified alongside an `os_profile` block (and vice versa), so that this is caught at plan time rather than apply
+func orchestratedVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("os_profile") {
+		return nil
+	}
+
+	if _, ok := d.GetOk("os_profile"); !ok {
+		// when no `os_profile` is specified the Scale Set is just a container which Virtual Machines can join
+		for _, field := range []string{"sku_name", "network_interface", "os_disk", "data_disk", "extension", "source_image_id", "source_image_reference"} {
+			if _, ok := d.GetOk(field); ok {
+				return fmt.Errorf("an `os_profile` block must be specified when `%s` is set", field)
+			}
+		}
+
+		return nil
+	}
+
+	for _, field := range []string{"sku_name", "network_interface", "os_disk"} {
+		if !d.NewValueKnown(field) {
+			continue
+		}
+
+		if _, ok := d.GetOk(field); !ok {
+			return fmt.Errorf("`%s` must be specified when an `os_profile` block is set", field)
+		}
+	}
+
+	return nil
+}
+
+func flattenOrchestratedVirtualMachineScaleSetVMProfile(d *schema.ResourceData, profile *compute.VirtualMachineScaleSetVMProfile) error {
+	if profile == nil {
+		return nil
+	}
+
+	if err := d.Set("boot_diagnostics", flattenBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
+		return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
+	}
+
+	osProfile, err := FlattenOrchestratedVirtualMachineScaleSetOSProfile(profile.OsProfile, d)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("os_profile", osProfile); err != nil {
+		return fmt.Errorf("setting `os_profile`: %+v", err)
+	}
+
+	if storageProfile := profile.StorageProfile; storageProfile != nil {
+		if err := d.Set("os_disk", FlattenVirtualMachineScaleSetOSDisk(storageProfile.OsDisk)); err != nil {
+			return fmt.Errorf("setting `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("data_disk", FlattenVirtualMachineScaleSetDataDisk(storageProfile.DataDisks)); err != nil {
+			return fmt.Errorf("setting `data_disk`: %+v", err)
+		}
+
+		if err := d.Set("source_image_reference", flattenSourceImageReference(storageProfile.ImageReference)); err != nil {
+			return fmt.Errorf("setting `source_image_reference`: %+v", err)
+		}
+
+		var storageImageId string
+		if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
+			storageImageId = *storageProfile.ImageReference.ID
+		}
+		d.Set("source_image_id", storageImageId)
+	}
+
+	if nwProfile := profile.NetworkProfile; nwProfile != nil {
+		if err := d.Set("network_interface", FlattenVirtualMachineScaleSetNetworkInterface(nwProfile.NetworkInterfaceConfigurations)); err != nil {
+			return fmt.Errorf("setting `network_interface`: %+v", err)
+		}
+	}
+
+	extensionProfile, err := flattenVirtualMachineScaleSetExtensions(profile.ExtensionProfile, d)
+	if err != nil {
+		return fmt.Errorf("flattening `extension`: %+v", err)
+	}
+	if err := d.Set("extension", extensionProfile); err != nil {
+		return fmt.Errorf("setting `extension`: %+v", err)
+	}
+
+	extensionsTimeBudget := "PT1H30M"
+	if profile.ExtensionProfile != nil && profile.ExtensionProfile.ExtensionsTimeBudget != nil {
+		extensionsTimeBudget = *profile.ExtensionProfile.ExtensionsTimeBudget
+	}
+	d.Set("extensions_time_budget", extensionsTimeBudget)
+
+	return nil
+}
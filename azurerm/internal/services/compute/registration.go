@@ -49,6 +49,8 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_dedicated_host":                         resourceDedicatedHost(),
 		"azurerm_dedicated_host_group":                   resourceDedicatedHostGroup(),
 		"azurerm_disk_encryption_set":                    resourceDiskEncryptionSet(),
+		"azurerm_gallery_application":                    resourceGalleryApplication(),
+		"azurerm_gallery_application_version":            resourceGalleryApplicationVersion(),
 		"azurerm_image":                                  resourceImage(),
 		"azurerm_managed_disk":                           resourceManagedDisk(),
 		"azurerm_disk_access":                            resourceDiskAccess(),
@@ -175,6 +175,104 @@ func TestSharedImageVersionName(t *testing.T) {
 	}
 }
 
+func TestGalleryApplicationName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.1-2_3",
+			ShouldError: false,
+		},
+		{
+			Input:       "-hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello_",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello,123",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(80),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(81),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestGalleryApplicationVersionName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.2.3",
+			ShouldError: false,
+		},
+		{
+			Input:       "latest",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.2",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.2.3.4",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationVersionName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
 func TestVirtualMachineTimeZone(t *testing.T) {
 	cases := []struct {
 		Value  string
@@ -37,8 +37,10 @@ func assertOrchestratedVirtualMachineScaleSet(resp compute.VirtualMachineScaleSe
 		return fmt.Errorf("`properties` is nil")
 	}
 
-	if resp.VirtualMachineScaleSetProperties.VirtualMachineProfile != nil {
-		return fmt.Errorf("the virtual machine scale set is an orchestration virtual machine scale set")
+	// Scale Sets in Flexible mode can optionally specify a Virtual Machine Profile, so we need to check the mode here
+	props := *resp.VirtualMachineScaleSetProperties
+	if props.OrchestrationMode == compute.Uniform || (props.OrchestrationMode == "" && props.VirtualMachineProfile != nil) {
+		return fmt.Errorf("the virtual machine scale set is not an orchestrated virtual machine scale set")
 	}
 
 	return nil
@@ -64,6 +66,10 @@ func importVirtualMachineScaleSet(osType compute.OperatingSystemTypes, resourceT
 			return []*schema.ResourceData{}, fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
 		}
 
+		if vm.VirtualMachineScaleSetProperties.OrchestrationMode == compute.Flexible {
+			return []*schema.ResourceData{}, fmt.Errorf("The %q resource doesn't support Virtual Machine Scale Sets in Flexible orchestration mode - use `azurerm_orchestrated_virtual_machine_scale_set` instead", resourceType)
+		}
+
 		if vm.VirtualMachineScaleSetProperties.VirtualMachineProfile == nil {
 			return []*schema.ResourceData{}, fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): `properties.virtualMachineProfile` was nil", id.Name, id.ResourceGroup)
 		}
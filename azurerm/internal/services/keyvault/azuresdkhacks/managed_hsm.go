@@ -0,0 +1,183 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/gofrs/uuid"
+)
+
+// The vendored Azure SDK for Go doesn't ship a Managed HSM API which supports Network ACLs or purging a deleted
+// Managed HSM (the newest is 2020-04-01-preview, which supports neither) - and upgrading the SDK would bump every
+// other Key Vault API at the same time. Rather than mixing two API versions within a single resource, every
+// Managed HSM operation goes through this small client against 2021-04-01-preview until the SDK catches up.
+const managedHsmAPIVersion = "2021-04-01-preview"
+
+type ManagedHsmClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+func NewManagedHsmClientWithBaseURI(baseURI string, subscriptionID string) ManagedHsmClient {
+	return ManagedHsmClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+type ManagedHsm struct {
+	autorest.Response `json:"-"`
+	ID                *string               `json:"id,omitempty"`
+	Name              *string               `json:"name,omitempty"`
+	Type              *string               `json:"type,omitempty"`
+	Location          *string               `json:"location,omitempty"`
+	Sku               *ManagedHsmSku        `json:"sku,omitempty"`
+	Tags              map[string]*string    `json:"tags,omitempty"`
+	Properties        *ManagedHsmProperties `json:"properties,omitempty"`
+}
+
+type ManagedHsmSku struct {
+	Family string `json:"family,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+type ManagedHsmProperties struct {
+	TenantID                  *uuid.UUID          `json:"tenantId,omitempty"`
+	InitialAdminObjectIds     *[]string           `json:"initialAdminObjectIds,omitempty"`
+	HsmURI                    *string             `json:"hsmUri,omitempty"`
+	EnableSoftDelete          *bool               `json:"enableSoftDelete,omitempty"`
+	SoftDeleteRetentionInDays *int32              `json:"softDeleteRetentionInDays,omitempty"`
+	EnablePurgeProtection     *bool               `json:"enablePurgeProtection,omitempty"`
+	CreateMode                string              `json:"createMode,omitempty"`
+	NetworkAcls               *MHSMNetworkRuleSet `json:"networkAcls,omitempty"`
+}
+
+type MHSMNetworkRuleSet struct {
+	Bypass        string `json:"bypass,omitempty"`
+	DefaultAction string `json:"defaultAction,omitempty"`
+}
+
+const (
+	ManagedHsmCreateModeDefault = "default"
+	ManagedHsmSkuFamilyB        = "B"
+)
+
+// CreateOrUpdate creates or updates a Managed HSM and waits for the operation to complete.
+func (client ManagedHsmClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, name string, parameters ManagedHsm) error {
+	req, err := client.managedHsmPreparer(ctx, resourceGroupName, name, autorest.AsPut(), &parameters)
+	return client.longRunning(ctx, "CreateOrUpdate", req, err)
+}
+
+// Update updates a Managed HSM and waits for the operation to complete.
+func (client ManagedHsmClient) Update(ctx context.Context, resourceGroupName string, name string, parameters ManagedHsm) error {
+	req, err := client.managedHsmPreparer(ctx, resourceGroupName, name, autorest.AsPatch(), &parameters)
+	return client.longRunning(ctx, "Update", req, err)
+}
+
+// Delete deletes a Managed HSM (which then remains soft-deleted) and waits for the operation to complete.
+func (client ManagedHsmClient) Delete(ctx context.Context, resourceGroupName string, name string) error {
+	req, err := client.managedHsmPreparer(ctx, resourceGroupName, name, autorest.AsDelete(), nil)
+	return client.longRunning(ctx, "Delete", req, err)
+}
+
+// Get retrieves a Managed HSM.
+func (client ManagedHsmClient) Get(ctx context.Context, resourceGroupName string, name string) (result ManagedHsm, err error) {
+	req, err := client.managedHsmPreparer(ctx, resourceGroupName, name, autorest.AsGet(), nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.ManagedHsmClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.ManagedHsmClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.ManagedHsmClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// PurgeDeleted permanently deletes the specified soft-deleted Managed HSM and waits for the operation to complete.
+func (client ManagedHsmClient) PurgeDeleted(ctx context.Context, location string, name string) error {
+	pathParameters := map[string]interface{}{
+		"location":       autorest.Encode("path", location),
+		"name":           autorest.Encode("path", name),
+		"subscriptionId": autorest.Encode("path", client.SubscriptionID),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.KeyVault/locations/{location}/deletedManagedHSMs/{name}/purge", pathParameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": managedHsmAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+
+	return client.longRunning(ctx, "PurgeDeleted", req, err)
+}
+
+func (client ManagedHsmClient) managedHsmPreparer(ctx context.Context, resourceGroupName string, name string, method autorest.PrepareDecorator, parameters *ManagedHsm) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"name":              autorest.Encode("path", name),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	decorators := []autorest.PrepareDecorator{
+		method,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.KeyVault/managedHSMs/{name}", pathParameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": managedHsmAPIVersion,
+		}),
+	}
+
+	if parameters != nil {
+		// these are read-only, so they're never sent
+		parameters.ID = nil
+		parameters.Name = nil
+		parameters.Type = nil
+		if parameters.Properties != nil {
+			parameters.Properties.HsmURI = nil
+		}
+
+		decorators = append(decorators, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(parameters))
+	}
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client ManagedHsmClient) longRunning(ctx context.Context, operation string, req *http.Request, err error) error {
+	if err != nil {
+		return autorest.NewErrorWithError(err, "keyvault.ManagedHsmClient", operation, nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "keyvault.ManagedHsmClient", operation, resp, "Failure sending request")
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "keyvault.ManagedHsmClient", operation, resp, "Failure responding to request")
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
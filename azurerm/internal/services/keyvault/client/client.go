@@ -4,14 +4,19 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/azuresdkhacks"
 )
 
 type Client struct {
+	ManagedHsmClient *azuresdkhacks.ManagedHsmClient
 	ManagementClient *keyvaultmgmt.BaseClient
 	VaultsClient     *keyvault.VaultsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	managedHsmClient := azuresdkhacks.NewManagedHsmClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
+
 	managementClient := keyvaultmgmt.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
@@ -19,6 +24,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		ManagedHsmClient: &managedHsmClient,
 		ManagementClient: &managementClient,
 		VaultsClient:     &vaultsClient,
 	}
@@ -154,3 +154,26 @@ func nestedItemResourceImporter(d *schema.ResourceData, meta interface{}) ([]*sc
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func versionlessNestedItemResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("parsing ID %q for Key Vault Child import: %v", d.Id(), err)
+	}
+	if id.Version != "" {
+		return []*schema.ResourceData{d}, fmt.Errorf("expected ID %q for Key Vault Child import to not have a version", d.Id())
+	}
+
+	keyVaultId, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	d.Set("key_vault_id", keyVaultId)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,213 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultCertificateContacts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultCertificateContactsCreate,
+		Read:   resourceKeyVaultCertificateContactsRead,
+		Update: resourceKeyVaultCertificateContactsUpdate,
+		Delete: resourceKeyVaultCertificateContactsDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.CertificateContactsID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VaultID,
+			},
+
+			"contact": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"phone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKeyVaultCertificateContactsCreate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("retrieving base uri for %s: %+v", *keyVaultId, err)
+	}
+
+	id, err := parse.NewCertificateContactsID(*keyVaultBaseUri)
+	if err != nil {
+		return err
+	}
+
+	// Key Vault returns a 404 when no Contacts have been configured
+	existing, err := client.GetCertificateContacts(ctx, id.KeyVaultBaseUrl)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Certificate Contacts for %s: %+v", *keyVaultId, err)
+		}
+	}
+	if existing.ContactList != nil && len(*existing.ContactList) > 0 {
+		return tf.ImportAsExistsError("azurerm_key_vault_certificate_contacts", id.ID())
+	}
+
+	contacts := keyvault.Contacts{
+		ContactList: expandKeyVaultCertificateContactList(d.Get("contact").(*schema.Set).List()),
+	}
+	if _, err := client.SetCertificateContacts(ctx, id.KeyVaultBaseUrl, contacts); err != nil {
+		return fmt.Errorf("setting Certificate Contacts for %s: %+v", *keyVaultId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultCertificateContactsRead(d, meta)
+}
+
+func resourceKeyVaultCertificateContactsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	contacts := keyvault.Contacts{
+		ContactList: expandKeyVaultCertificateContactList(d.Get("contact").(*schema.Set).List()),
+	}
+	if _, err := client.SetCertificateContacts(ctx, id.KeyVaultBaseUrl, contacts); err != nil {
+		return fmt.Errorf("updating Certificate Contacts (Key Vault %q): %+v", id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceKeyVaultCertificateContactsRead(d, meta)
+}
+
+func resourceKeyVaultCertificateContactsRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for Certificate Contacts exists: %v", *keyVaultId, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] %s for Certificate Contacts was not found - removing from state", *keyVaultId)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetCertificateContacts(ctx, id.KeyVaultBaseUrl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Contacts for %s were not found - removing from state", *keyVaultId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Certificate Contacts for %s: %+v", *keyVaultId, err)
+	}
+
+	d.Set("key_vault_id", keyVaultId.ID())
+
+	if err := d.Set("contact", flattenKeyVaultCertificateContactList(resp)); err != nil {
+		return fmt.Errorf("setting `contact`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceKeyVaultCertificateContactsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.DeleteCertificateContacts(ctx, id.KeyVaultBaseUrl); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("deleting Certificate Contacts (Key Vault %q): %+v", id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
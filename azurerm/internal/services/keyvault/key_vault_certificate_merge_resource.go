@@ -0,0 +1,220 @@
+package keyvault
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultCertificateMerge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultCertificateMergeCreate,
+		Read:   resourceKeyVaultCertificateMergeRead,
+		Delete: resourceKeyVaultCertificateMergeDelete,
+
+		// the ID is the ID of the Certificate Version which was created by the merge
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseNestedItemID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_certificate_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			// the signed Certificate, optionally followed by the rest of the chain, in PEM format
+			"certificate_chain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.CertificateChain,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultCertificateMergeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	certificateId, err := parse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_certificate_id").(string))
+	if err != nil {
+		return err
+	}
+	if certificateId.NestedItemType != "certificates" {
+		return fmt.Errorf("expected `key_vault_certificate_id` to be the ID of a Certificate but got %q", certificateId.ID())
+	}
+
+	operation, err := client.GetCertificateOperation(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(operation.Response) {
+			return fmt.Errorf("Certificate %q (Key Vault %q) has no pending operation to merge into", certificateId.Name, certificateId.KeyVaultBaseUrl)
+		}
+		return fmt.Errorf("retrieving pending operation for Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+	}
+
+	if operation.Status == nil || !strings.EqualFold(*operation.Status, "inProgress") {
+		// the pending operation has already completed, so a Certificate has been merged into it
+		existing, err := client.GetCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+		}
+		if existing.ID != nil && existing.X509Thumbprint != nil {
+			return tf.ImportAsExistsError("azurerm_key_vault_certificate_merge", *existing.ID)
+		}
+
+		status := ""
+		if operation.Status != nil {
+			status = *operation.Status
+		}
+		return fmt.Errorf("expected the pending operation for Certificate %q (Key Vault %q) to be `inProgress` but got %q", certificateId.Name, certificateId.KeyVaultBaseUrl, status)
+	}
+
+	parameters := keyvault.CertificateMergeParameters{
+		X509Certificates: expandKeyVaultCertificateChain(d.Get("certificate_chain").(string)),
+	}
+	resp, err := client.MergeCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("merging Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+	}
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("merging Certificate %q (Key Vault %q): `id` was nil", certificateId.Name, certificateId.KeyVaultBaseUrl)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceKeyVaultCertificateMergeRead(d, meta)
+}
+
+func resourceKeyVaultCertificateMergeRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for Certificate %q exists: %v", *keyVaultId, id.Name, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Certificate %q was not found in %s - removing from state", id.Name, *keyVaultId)
+		d.SetId("")
+		return nil
+	}
+
+	cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			log.Printf("[DEBUG] Version %q of Certificate %q was not found in Key Vault at URI %q - removing from state", id.Version, id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Version %q of Certificate %q (Key Vault %q): %+v", id.Version, id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	// `key_vault_certificate_id` can be either the versioned or versionless ID, so it's only set when importing
+	if _, ok := d.GetOk("key_vault_certificate_id"); !ok {
+		d.Set("key_vault_certificate_id", id.VersionlessID())
+	}
+
+	d.Set("version", id.Version)
+	d.Set("secret_id", cert.Sid)
+
+	thumbprint := ""
+	if v := cert.X509Thumbprint; v != nil {
+		x509Thumbprint, err := base64.RawURLEncoding.DecodeString(*v)
+		if err != nil {
+			return err
+		}
+
+		thumbprint = strings.ToUpper(hex.EncodeToString(x509Thumbprint))
+	}
+	d.Set("thumbprint", thumbprint)
+
+	return nil
+}
+
+func resourceKeyVaultCertificateMergeDelete(d *schema.ResourceData, _ interface{}) error {
+	// a merge can't be undone - the Certificate itself is removed by deleting the `azurerm_key_vault_certificate`
+	log.Printf("[DEBUG] Merged Certificate %q can't be unmerged - removing from state", d.Id())
+	return nil
+}
+
+// expandKeyVaultCertificateChain returns the DER encoded Certificates within the PEM encoded chain
+func expandKeyVaultCertificateChain(input string) *[][]byte {
+	results := make([][]byte, 0)
+
+	rest := []byte(input)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		results = append(results, block.Bytes)
+	}
+
+	return &results
+}
@@ -0,0 +1,187 @@
+package keyvault_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultCertificateMergeResource struct {
+}
+
+func TestAccKeyVaultCertificateMerge_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+	chainPath := createKeyVaultCertificateChainPath(t)
+	defer os.RemoveAll(filepath.Dir(chainPath))
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			// the CSR is only known once the pending Certificate exists, so it's signed by a local CA here
+			Config: r.pending(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("azurerm_key_vault_certificate.test").Key("certificate_signing_request").Exists(),
+				signKeyVaultCertificateSigningRequest("azurerm_key_vault_certificate.test", chainPath),
+			),
+		},
+		{
+			Config: r.basic(data, chainPath),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").Exists(),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep("certificate_chain", "key_vault_certificate_id"),
+	})
+}
+
+func TestAccKeyVaultCertificateMerge_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+	chainPath := createKeyVaultCertificateChainPath(t)
+	defer os.RemoveAll(filepath.Dir(chainPath))
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.pending(data),
+			Check: resource.ComposeTestCheckFunc(
+				signKeyVaultCertificateSigningRequest("azurerm_key_vault_certificate.test", chainPath),
+			),
+		},
+		{
+			Config: r.basic(data, chainPath),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, chainPath),
+			ExpectError: acceptance.RequiresImportError("azurerm_key_vault_certificate_merge"),
+		},
+	})
+}
+
+func (KeyVaultCertificateMergeResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Version %q of Certificate %q (Key Vault %q): %+v", id.Version, id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(resp.X509Thumbprint != nil), nil
+}
+
+func (KeyVaultCertificateMergeResource) pending(data acceptance.TestData) string {
+	return KeyVaultCertificateResource{}.basicGenerateUnknownIssuer(data)
+}
+
+func (r KeyVaultCertificateMergeResource) basic(data acceptance.TestData, chainPath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_merge" "test" {
+  key_vault_certificate_id = azurerm_key_vault_certificate.test.id
+  certificate_chain        = file("%s")
+}
+`, r.pending(data), filepath.ToSlash(chainPath))
+}
+
+func (r KeyVaultCertificateMergeResource) requiresImport(data acceptance.TestData, chainPath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_merge" "import" {
+  key_vault_certificate_id = azurerm_key_vault_certificate_merge.test.key_vault_certificate_id
+  certificate_chain        = azurerm_key_vault_certificate_merge.test.certificate_chain
+}
+`, r.basic(data, chainPath))
+}
+
+func createKeyVaultCertificateChainPath(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("creating directory for the certificate chain: %+v", err)
+	}
+
+	return filepath.Join(dir, "chain.pem")
+}
+
+// signKeyVaultCertificateSigningRequest signs the CSR exposed by the Certificate using a throwaway CA,
+// writing the signed Certificate followed by the CA Certificate to `chainPath`
+func signKeyVaultCertificateSigningRequest(resourceName, chainPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		block, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate_signing_request"]))
+		if block == nil {
+			return fmt.Errorf("`certificate_signing_request` for %q wasn't PEM encoded", resourceName)
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("parsing `certificate_signing_request`: %+v", err)
+		}
+
+		caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return fmt.Errorf("generating CA key: %+v", err)
+		}
+		caTemplate := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "acctest-ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(24 * time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		caCertificate, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+		if err != nil {
+			return fmt.Errorf("creating CA certificate: %+v", err)
+		}
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(24 * time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		}
+		certificate, err := x509.CreateCertificate(rand.Reader, template, caTemplate, csr.PublicKey, caKey)
+		if err != nil {
+			return fmt.Errorf("signing certificate: %+v", err)
+		}
+
+		chain := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCertificate})...)
+		return os.WriteFile(chainPath, chain, 0644)
+	}
+}
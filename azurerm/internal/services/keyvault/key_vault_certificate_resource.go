@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"math"
@@ -351,6 +352,11 @@ func resourceKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
+			"certificate_signing_request": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.ForceNewSchema(),
 		},
 	}
@@ -572,6 +578,25 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("thumbprint", thumbprint)
 
+	// Certificates issued by a CA which isn't integrated with Key Vault remain pending until the signed
+	// Certificate is merged - the CSR is only available from the pending operation, so we retain the
+	// last known value once the operation has been removed
+	certificateSigningRequest := ""
+	if policy := cert.Policy; policy != nil && policy.IssuerParameters != nil && policy.IssuerParameters.Name != nil && strings.EqualFold(*policy.IssuerParameters.Name, "unknown") {
+		certificateSigningRequest = d.Get("certificate_signing_request").(string)
+
+		operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(operation.Response) {
+				return fmt.Errorf("retrieving pending operation for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+		}
+		if operation.Csr != nil && len(*operation.Csr) > 0 {
+			certificateSigningRequest = flattenKeyVaultCertificateSigningRequest(*operation.Csr)
+		}
+	}
+	d.Set("certificate_signing_request", certificateSigningRequest)
+
 	return tags.FlattenAndSet(d, cert.Tags)
 }
 
@@ -923,3 +948,11 @@ func expandKeyVaultCertificate(v interface{}) KeyVaultCertificateImportParameter
 		CertificatePassword: cert["password"].(string),
 	}
 }
+
+func flattenKeyVaultCertificateSigningRequest(input []byte) string {
+	block := &pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: input,
+	}
+	return string(pem.EncodeToMemory(block))
+}
@@ -0,0 +1,368 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ManagedHardwareSecurityModuleID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedHardwareSecurityModuleName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard_B1",
+					"Custom_B32",
+				}, false),
+			},
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			// the initial administrators can only be specified when the Managed HSM is created
+			"admin_object_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"soft_delete_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      90,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"network_acls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+								"Deny",
+							}, false),
+						},
+
+						"bypass": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AzureServices",
+								"None",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"hsm_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewManagedHardwareSecurityModuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedHSMName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module", id.ID())
+	}
+
+	tenantId := uuid.FromStringOrNil(d.Get("tenant_id").(string))
+	parameters := azuresdkhacks.ManagedHsm{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &azuresdkhacks.ManagedHsmProperties{
+			TenantID:                  &tenantId,
+			InitialAdminObjectIds:     utils.ExpandStringSlice(d.Get("admin_object_ids").(*schema.Set).List()),
+			EnableSoftDelete:          utils.Bool(true),
+			SoftDeleteRetentionInDays: utils.Int32(int32(d.Get("soft_delete_retention_days").(int))),
+			CreateMode:                azuresdkhacks.ManagedHsmCreateModeDefault,
+			NetworkAcls:               expandManagedHardwareSecurityModuleNetworkAcls(d.Get("network_acls").([]interface{})),
+		},
+		Sku: &azuresdkhacks.ManagedHsmSku{
+			Family: azuresdkhacks.ManagedHsmSkuFamilyB,
+			Name:   d.Get("sku_name").(string),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// Purge Protection can't be disabled once enabled, so it's only sent when enabled
+	if d.Get("purge_protection_enabled").(bool) {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
+	if err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedHSMName, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHardwareSecurityModuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedHSMName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ManagedHSMName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	skuName := ""
+	if sku := resp.Sku; sku != nil {
+		skuName = sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if props := resp.Properties; props != nil {
+		tenantId := ""
+		if props.TenantID != nil {
+			tenantId = props.TenantID.String()
+		}
+		d.Set("tenant_id", tenantId)
+
+		if err := d.Set("admin_object_ids", utils.FlattenStringSlice(props.InitialAdminObjectIds)); err != nil {
+			return fmt.Errorf("setting `admin_object_ids`: %+v", err)
+		}
+
+		softDeleteRetentionDays := 90
+		if props.SoftDeleteRetentionInDays != nil {
+			softDeleteRetentionDays = int(*props.SoftDeleteRetentionInDays)
+		}
+		d.Set("soft_delete_retention_days", softDeleteRetentionDays)
+
+		purgeProtectionEnabled := false
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+		d.Set("purge_protection_enabled", purgeProtectionEnabled)
+
+		d.Set("hsm_uri", props.HsmURI)
+
+		if err := d.Set("network_acls", flattenManagedHardwareSecurityModuleNetworkAcls(props.NetworkAcls)); err != nil {
+			return fmt.Errorf("setting `network_acls`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHardwareSecurityModuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedHSMName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	update := azuresdkhacks.ManagedHsm{
+		Properties: &azuresdkhacks.ManagedHsmProperties{},
+	}
+
+	if d.HasChange("purge_protection_enabled") {
+		newValue := d.Get("purge_protection_enabled").(bool)
+
+		oldValue := false
+		if existing.Properties.EnablePurgeProtection != nil {
+			oldValue = *existing.Properties.EnablePurgeProtection
+		}
+
+		if oldValue && !newValue {
+			return fmt.Errorf("updating %s: once Purge Protection has been Enabled it's not possible to disable it", *id)
+		}
+
+		update.Properties.EnablePurgeProtection = utils.Bool(newValue)
+	}
+
+	if d.HasChange("network_acls") {
+		update.Properties.NetworkAcls = expandManagedHardwareSecurityModuleNetworkAcls(d.Get("network_acls").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.Update(ctx, id.ResourceGroup, id.ManagedHSMName, update); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHardwareSecurityModuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedHSMName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *id)
+	}
+
+	purgeProtectionEnabled := false
+	if props := existing.Properties; props != nil && props.EnablePurgeProtection != nil {
+		purgeProtectionEnabled = *props.EnablePurgeProtection
+	}
+
+	if err := client.Delete(ctx, id.ResourceGroup, id.ManagedHSMName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	// Managed HSM's are always soft-deleted, so purge it permanently if the feature flag is enabled
+	if meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		// Managed HSM's with Purge Protection Enabled cannot be purged unless done by Azure
+		if purgeProtectionEnabled {
+			log.Printf("[DEBUG] %s has Purge Protection Enabled and will be purged automatically by Azure", *id)
+			return nil
+		}
+
+		log.Printf("[DEBUG] Purging %s..", *id)
+		if err := client.PurgeDeleted(ctx, location.Normalize(*existing.Location), id.ManagedHSMName); err != nil {
+			return fmt.Errorf("purging %s: %+v", *id, err)
+		}
+		log.Printf("[DEBUG] Purged %s.", *id)
+	}
+
+	return nil
+}
+
+func expandManagedHardwareSecurityModuleNetworkAcls(input []interface{}) *azuresdkhacks.MHSMNetworkRuleSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &azuresdkhacks.MHSMNetworkRuleSet{
+		Bypass:        v["bypass"].(string),
+		DefaultAction: v["default_action"].(string),
+	}
+}
+
+func flattenManagedHardwareSecurityModuleNetworkAcls(input *azuresdkhacks.MHSMNetworkRuleSet) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"bypass":         input.Bypass,
+			"default_action": input.DefaultAction,
+		},
+	}
+}
@@ -0,0 +1,295 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultManagedStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultManagedStorageAccountCreate,
+		Read:   resourceKeyVaultManagedStorageAccountRead,
+		Update: resourceKeyVaultManagedStorageAccountUpdate,
+		Delete: resourceKeyVaultManagedStorageAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: versionlessNestedItemResourceImporter,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9a-zA-Z]+$`),
+					"may only contain alphanumeric characters",
+				),
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"storage_account_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"key1",
+					"key2",
+				}, false),
+			},
+
+			"regenerate_key_automatically": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"regeneration_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKeyVaultManagedStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Managed Storage Account %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	existing, err := client.GetStorageAccount(ctx, *keyVaultBaseUrl, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Managed Storage Account %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_storage_account", *existing.ID)
+	}
+
+	regenerateKeyAutomatically := d.Get("regenerate_key_automatically").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+	if err := validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically, regenerationPeriod); err != nil {
+		return err
+	}
+
+	parameters := keyvault.StorageAccountCreateParameters{
+		ResourceID:        utils.String(d.Get("storage_account_id").(string)),
+		ActiveKeyName:     utils.String(d.Get("storage_account_key").(string)),
+		AutoRegenerateKey: utils.Bool(regenerateKeyAutomatically),
+		StorageAccountAttributes: &keyvault.StorageAccountAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	resp, err := client.SetStorageAccount(ctx, *keyVaultBaseUrl, name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating Managed Storage Account %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Managed Storage Account %q (Key Vault %q)", name, *keyVaultBaseUrl)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceKeyVaultManagedStorageAccountRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	regenerateKeyAutomatically := d.Get("regenerate_key_automatically").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+	if err := validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically, regenerationPeriod); err != nil {
+		return err
+	}
+
+	parameters := keyvault.StorageAccountUpdateParameters{
+		ActiveKeyName:     utils.String(d.Get("storage_account_key").(string)),
+		AutoRegenerateKey: utils.Bool(regenerateKeyAutomatically),
+		Tags:              tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.UpdateStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceKeyVaultManagedStorageAccountRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Managed Storage Account %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Managed Storage Account %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Managed Storage Account %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("storage_account_id", resp.ResourceID)
+	d.Set("storage_account_key", resp.ActiveKeyName)
+
+	regenerateKeyAutomatically := false
+	if resp.AutoRegenerateKey != nil {
+		regenerateKeyAutomatically = *resp.AutoRegenerateKey
+	}
+	d.Set("regenerate_key_automatically", regenerateKeyAutomatically)
+	d.Set("regeneration_period", resp.RegenerationPeriod)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", id.KeyVaultBaseUrl)
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Managed Storage Account %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Managed Storage Account %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	if resp, err := client.DeleteStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("deleting Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically bool, regenerationPeriod string) error {
+	if regenerateKeyAutomatically && regenerationPeriod == "" {
+		return fmt.Errorf("`regeneration_period` must be specified when `regenerate_key_automatically` is `true`")
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultManagedStorageAccountResource struct {
+}
+
+func TestAccKeyVaultManagedStorageAccount_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccount_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccount_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_account_key").HasValue("key2"),
+				check.That(data.ResourceName).Key("regenerate_key_automatically").HasValue("true"),
+				check.That(data.ResourceName).Key("regeneration_period").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedStorageAccountResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (KeyVaultManagedStorageAccountResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azuread_service_principal" "test" {
+  # the first-party Azure Key Vault application
+  application_id = "cfa8b339-82a2-471a-a3c9-0fc0be7a4093"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-KV-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Account Key Operator Service Role"
+  principal_id         = data.azuread_service_principal.test.object_id
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "get",
+      "delete",
+    ]
+
+    storage_permissions = [
+      "delete",
+      "deletesas",
+      "get",
+      "getsas",
+      "list",
+      "listsas",
+      "regeneratekey",
+      "set",
+      "setsas",
+      "update",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r KeyVaultManagedStorageAccountResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account" "test" {
+  name                = "acctestmsa%s"
+  key_vault_id        = azurerm_key_vault.test.id
+  storage_account_id  = azurerm_storage_account.test.id
+  storage_account_key = "key1"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedStorageAccountResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account" "import" {
+  name                = azurerm_key_vault_managed_storage_account.test.name
+  key_vault_id        = azurerm_key_vault_managed_storage_account.test.key_vault_id
+  storage_account_id  = azurerm_key_vault_managed_storage_account.test.storage_account_id
+  storage_account_key = azurerm_key_vault_managed_storage_account.test.storage_account_key
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultManagedStorageAccountResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_storage_account" "test" {
+  name                         = "acctestmsa%s"
+  key_vault_id                 = azurerm_key_vault.test.id
+  storage_account_id           = azurerm_storage_account.test.id
+  storage_account_key          = "key2"
+  regenerate_key_automatically = true
+  regeneration_period          = "P30D"
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString)
+}
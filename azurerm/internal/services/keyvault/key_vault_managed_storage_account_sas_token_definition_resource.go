@@ -0,0 +1,250 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	sasDefinitionParameterSasType        = "sasType"
+	sasDefinitionParameterValidityPeriod = "validityPeriod"
+)
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultManagedStorageAccountSasTokenDefinitionCreate,
+		Read:   resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead,
+		Update: resourceKeyVaultManagedStorageAccountSasTokenDefinitionUpdate,
+		Delete: resourceKeyVaultManagedStorageAccountSasTokenDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if _, err := parse.SasDefinitionID(d.Id()); err != nil {
+					return []*schema.ResourceData{d}, fmt.Errorf("parsing ID %q for Key Vault SAS Definition import: %v", d.Id(), err)
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9a-zA-Z]+$`),
+					"may only contain alphanumeric characters",
+				),
+			},
+
+			"managed_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+			},
+
+			"sas_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"account",
+					"service",
+				}, false),
+			},
+
+			"validity_period": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			// the remaining SAS parameters, such as `signedServices` and `signedPermission`
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	storageAccountId, err := parse.ParseOptionallyVersionedNestedItemID(d.Get("managed_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+	if storageAccountId.NestedItemType != "storage" {
+		return fmt.Errorf("expected `managed_storage_account_id` to be the ID of a Managed Storage Account but got %q", storageAccountId.ID())
+	}
+
+	id, err := parse.NewSasDefinitionID(storageAccountId.KeyVaultBaseUrl, storageAccountId.Name, name)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing SAS Definition %q (Managed Storage Account %q / Key Vault %q): %s", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_storage_account_sas_token_definition", *existing.ID)
+	}
+
+	parameters := keyvault.SasDefinitionCreateParameters{
+		Parameters: expandKeyVaultSasDefinitionParameters(d),
+		SasDefinitionAttributes: &keyvault.SasDefinitionAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.SetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := keyvault.SasDefinitionUpdateParameters{
+		Parameters: expandKeyVaultSasDefinitionParameters(d),
+		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.UpdateSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SAS Definition %q was not found in Managed Storage Account %q / Key Vault %q - removing from state", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_storage_account_id", id.ManagedStorageAccountID())
+	d.Set("secret_id", resp.SecretID)
+
+	sasType := ""
+	validityPeriod := ""
+	additionalParameters := make(map[string]interface{})
+	for k, v := range resp.Parameters {
+		if v == nil {
+			continue
+		}
+
+		switch k {
+		case sasDefinitionParameterSasType:
+			sasType = *v
+		case sasDefinitionParameterValidityPeriod:
+			validityPeriod = *v
+		default:
+			additionalParameters[k] = *v
+		}
+	}
+	d.Set("sas_type", sasType)
+	d.Set("validity_period", validityPeriod)
+	if err := d.Set("parameters", additionalParameters); err != nil {
+		return fmt.Errorf("setting `parameters`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedStorageAccountSasTokenDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.DeleteSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("deleting SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultSasDefinitionParameters(d *schema.ResourceData) map[string]*string {
+	output := make(map[string]*string)
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		output[k] = utils.String(v.(string))
+	}
+
+	output[sasDefinitionParameterSasType] = utils.String(d.Get("sas_type").(string))
+	output[sasDefinitionParameterValidityPeriod] = utils.String(d.Get("validity_period").(string))
+
+	return output
+}
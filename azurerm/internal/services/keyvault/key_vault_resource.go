@@ -200,9 +200,12 @@ func resourceKeyVault() *schema.Resource {
 					ValidateFunc: validation.IntBetween(7, 90),
 				},
 
+				// can be managed inline or via the separate `azurerm_key_vault_certificate_contacts` resource
 				"contact": {
-					Type:     schema.TypeSet,
-					Optional: true,
+					Type:       schema.TypeSet,
+					ConfigMode: schema.SchemaConfigModeAttr,
+					Optional:   true,
+					Computed:   true,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"email": {
@@ -0,0 +1,59 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = CertificateContactsId{}
+
+type CertificateContactsId struct {
+	KeyVaultBaseUrl string
+}
+
+func NewCertificateContactsID(keyVaultBaseUrl string) (*CertificateContactsId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", keyVaultBaseUrl, err)
+	}
+	if hostParts := strings.Split(keyVaultUrl.Host, ":"); len(hostParts) > 1 {
+		keyVaultUrl.Host = hostParts[0]
+	}
+
+	return &CertificateContactsId{
+		KeyVaultBaseUrl: keyVaultUrl.String(),
+	}, nil
+}
+
+func (id CertificateContactsId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/certificates/contacts
+	segments := []string{
+		strings.TrimSuffix(id.KeyVaultBaseUrl, "/"),
+		"certificates",
+		"contacts",
+	}
+	return strings.Join(segments, "/")
+}
+
+// CertificateContactsID parses a Key Vault Certificate Contacts ID into a CertificateContactsId object
+func CertificateContactsID(input string) (*CertificateContactsId, error) {
+	// example: https://example-keyvault.vault.azure.net/certificates/contacts
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Certificate Contacts Id: %s", err)
+	}
+
+	path := strings.TrimPrefix(idURL.Path, "/")
+	path = strings.TrimSuffix(path, "/")
+
+	if path != "certificates/contacts" {
+		return nil, fmt.Errorf("expected the path of a KeyVault Certificate Contacts Id to be `certificates/contacts` but got %q", path)
+	}
+
+	return &CertificateContactsId{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+	}, nil
+}
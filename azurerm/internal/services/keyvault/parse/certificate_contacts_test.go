@@ -0,0 +1,95 @@
+package parse
+
+import "testing"
+
+func TestNewCertificateContactsID(t *testing.T) {
+	cases := []struct {
+		Scenario        string
+		keyVaultBaseUrl string
+		Expected        string
+		ExpectError     bool
+	}{
+		{
+			Scenario:        "empty values",
+			keyVaultBaseUrl: "",
+			Expected:        "",
+			ExpectError:     true,
+		},
+		{
+			Scenario:        "valid, no port",
+			keyVaultBaseUrl: "https://test.vault.azure.net",
+			Expected:        "https://test.vault.azure.net/certificates/contacts",
+			ExpectError:     false,
+		},
+		{
+			Scenario:        "valid, with port",
+			keyVaultBaseUrl: "https://test.vault.azure.net:443",
+			Expected:        "https://test.vault.azure.net/certificates/contacts",
+			ExpectError:     false,
+		},
+	}
+	for _, tc := range cases {
+		id, err := NewCertificateContactsID(tc.keyVaultBaseUrl)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Resource ID '%s': %+v", tc.keyVaultBaseUrl, err)
+				return
+			}
+			continue
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for %q to be %q, got %q", tc.keyVaultBaseUrl, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestCertificateContactsID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    CertificateContactsId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/issuers",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/contacts/extra",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/contacts",
+			ExpectError: false,
+			Expected: CertificateContactsId{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := CertificateContactsID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if id == nil {
+			t.Fatalf("Expected a CertificateContactsId to be parsed for ID '%s', got nil.", tc.Input)
+		}
+
+		if tc.Expected.KeyVaultBaseUrl != id.KeyVaultBaseUrl {
+			t.Fatalf("Expected 'KeyVaultBaseUrl' to be '%s', got '%s' for ID '%s'", tc.Expected.KeyVaultBaseUrl, id.KeyVaultBaseUrl, tc.Input)
+		}
+	}
+}
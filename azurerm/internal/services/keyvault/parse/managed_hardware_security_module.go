@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ManagedHardwareSecurityModuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ManagedHSMName string
+}
+
+func NewManagedHardwareSecurityModuleID(subscriptionId, resourceGroup, managedHSMName string) ManagedHardwareSecurityModuleId {
+	return ManagedHardwareSecurityModuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ManagedHSMName: managedHSMName,
+	}
+}
+
+func (id ManagedHardwareSecurityModuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Managed H S M Name %q", id.ManagedHSMName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed Hardware Security Module", segmentsStr)
+}
+
+func (id ManagedHardwareSecurityModuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/managedHSMs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName)
+}
+
+// ManagedHardwareSecurityModuleID parses a ManagedHardwareSecurityModule ID into an ManagedHardwareSecurityModuleId struct
+func ManagedHardwareSecurityModuleID(input string) (*ManagedHardwareSecurityModuleId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedHardwareSecurityModuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedHSMName, err = id.PopSegment("managedHSMs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,85 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = SasDefinitionId{}
+
+type SasDefinitionId struct {
+	KeyVaultBaseUrl    string
+	StorageAccountName string
+	Name               string
+}
+
+func NewSasDefinitionID(keyVaultBaseUrl, storageAccountName, name string) (*SasDefinitionId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", keyVaultBaseUrl, err)
+	}
+	if hostParts := strings.Split(keyVaultUrl.Host, ":"); len(hostParts) > 1 {
+		keyVaultUrl.Host = hostParts[0]
+	}
+
+	return &SasDefinitionId{
+		KeyVaultBaseUrl:    keyVaultUrl.String(),
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}, nil
+}
+
+func (id SasDefinitionId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/storage/exampleStorageAcc01/sas/exampleSasDefinition
+	segments := []string{
+		strings.TrimSuffix(id.KeyVaultBaseUrl, "/"),
+		"storage",
+		id.StorageAccountName,
+		"sas",
+		id.Name,
+	}
+	return strings.Join(segments, "/")
+}
+
+// ManagedStorageAccountID returns the ID of the Key Vault Managed Storage Account this SAS Definition belongs to
+func (id SasDefinitionId) ManagedStorageAccountID() string {
+	segments := []string{
+		strings.TrimSuffix(id.KeyVaultBaseUrl, "/"),
+		"storage",
+		id.StorageAccountName,
+	}
+	return strings.Join(segments, "/")
+}
+
+// SasDefinitionID parses a Key Vault Managed Storage Account SAS Definition ID into a SasDefinitionId object
+func SasDefinitionID(input string) (*SasDefinitionId, error) {
+	// example: https://example-keyvault.vault.azure.net/storage/exampleStorageAcc01/sas/exampleSasDefinition
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault SAS Definition Id: %s", err)
+	}
+
+	path := strings.TrimPrefix(idURL.Path, "/")
+	path = strings.TrimSuffix(path, "/")
+
+	components := strings.Split(path, "/")
+	if len(components) != 4 {
+		return nil, fmt.Errorf("KeyVault SAS Definition should contain 4 segments, got %d from %q", len(components), path)
+	}
+
+	if components[0] != "storage" {
+		return nil, fmt.Errorf("expected the first segment of %q to be `storage` but got %q", path, components[0])
+	}
+	if components[2] != "sas" {
+		return nil, fmt.Errorf("expected the third segment of %q to be `sas` but got %q", path, components[2])
+	}
+
+	return &SasDefinitionId{
+		KeyVaultBaseUrl:    fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		StorageAccountName: components[1],
+		Name:               components[3],
+	}, nil
+}
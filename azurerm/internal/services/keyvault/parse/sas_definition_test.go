@@ -0,0 +1,122 @@
+package parse
+
+import "testing"
+
+func TestNewSasDefinitionID(t *testing.T) {
+	cases := []struct {
+		Scenario        string
+		keyVaultBaseUrl string
+		Expected        string
+		ExpectError     bool
+	}{
+		{
+			Scenario:        "empty values",
+			keyVaultBaseUrl: "",
+			Expected:        "",
+			ExpectError:     true,
+		},
+		{
+			Scenario:        "valid, no port",
+			keyVaultBaseUrl: "https://test.vault.azure.net",
+			Expected:        "https://test.vault.azure.net/storage/account1/sas/definition1",
+			ExpectError:     false,
+		},
+		{
+			Scenario:        "valid, with port",
+			keyVaultBaseUrl: "https://test.vault.azure.net:443",
+			Expected:        "https://test.vault.azure.net/storage/account1/sas/definition1",
+			ExpectError:     false,
+		},
+	}
+	for _, tc := range cases {
+		id, err := NewSasDefinitionID(tc.keyVaultBaseUrl, "account1", "definition1")
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Resource ID '%s': %+v", tc.keyVaultBaseUrl, err)
+				return
+			}
+			continue
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for %q to be %q, got %q", tc.keyVaultBaseUrl, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestSasDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    SasDefinitionId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/sas",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/account1/sas/definition1",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/keys/definition1",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/sas/definition1",
+			ExpectError: false,
+			Expected: SasDefinitionId{
+				KeyVaultBaseUrl:    "https://my-keyvault.vault.azure.net/",
+				StorageAccountName: "account1",
+				Name:               "definition1",
+			},
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account1/sas/definition1/",
+			ExpectError: false,
+			Expected: SasDefinitionId{
+				KeyVaultBaseUrl:    "https://my-keyvault.vault.azure.net/",
+				StorageAccountName: "account1",
+				Name:               "definition1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := SasDefinitionID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if id == nil {
+			t.Fatalf("Expected a SasDefinitionId to be parsed for ID '%s', got nil.", tc.Input)
+		}
+
+		if tc.Expected.KeyVaultBaseUrl != id.KeyVaultBaseUrl {
+			t.Fatalf("Expected 'KeyVaultBaseUrl' to be '%s', got '%s' for ID '%s'", tc.Expected.KeyVaultBaseUrl, id.KeyVaultBaseUrl, tc.Input)
+		}
+
+		if tc.Expected.StorageAccountName != id.StorageAccountName {
+			t.Fatalf("Expected 'StorageAccountName' to be '%s', got '%s' for ID '%s'", tc.Expected.StorageAccountName, id.StorageAccountName, tc.Input)
+		}
+
+		if tc.Expected.Name != id.Name {
+			t.Fatalf("Expected 'Name' to be '%s', got '%s' for ID '%s'", tc.Expected.Name, id.Name, tc.Input)
+		}
+
+		if tc.Input != id.ID() && tc.Input != id.ID()+"/" {
+			t.Fatalf("Expected 'ID' to be '%s', got '%s'", tc.Input, id.ID())
+		}
+	}
+}
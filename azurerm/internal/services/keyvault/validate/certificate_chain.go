@@ -0,0 +1,50 @@
+package validate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// CertificateChain validates that the value is one or more PEM encoded X509 Certificates
+func CertificateChain(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	rest := []byte(value)
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			errors = append(errors, fmt.Errorf("%q may only contain PEM blocks of type `CERTIFICATE` but got %q", k, block.Type))
+			return warnings, errors
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			errors = append(errors, fmt.Errorf("parsing certificate %d in %q: %+v", count+1, k, err))
+			return warnings, errors
+		}
+
+		count++
+	}
+
+	if count == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one PEM encoded certificate", k))
+		return warnings, errors
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		errors = append(errors, fmt.Errorf("%q contains data which isn't a PEM encoded certificate", k))
+	}
+
+	return warnings, errors
+}
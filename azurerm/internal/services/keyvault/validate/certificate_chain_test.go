@@ -0,0 +1,85 @@
+package validate
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCertificateChain(t *testing.T) {
+	certificate := generateTestCertificatePEM(t)
+
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello-world",
+			ExpectError: true,
+		},
+		{
+			Input:       "-----BEGIN CERTIFICATE-----\naGVsbG8td29ybGQ=\n-----END CERTIFICATE-----\n",
+			ExpectError: true,
+		},
+		{
+			Input:       "-----BEGIN CERTIFICATE REQUEST-----\naGVsbG8td29ybGQ=\n-----END CERTIFICATE REQUEST-----\n",
+			ExpectError: true,
+		},
+		{
+			Input:       certificate,
+			ExpectError: false,
+		},
+		{
+			Input:       certificate + certificate,
+			ExpectError: false,
+		},
+		{
+			Input:       certificate + "hello-world",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CertificateChain(tc.Input, "certificate_chain")
+		if tc.ExpectError && len(errors) == 0 {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+		if !tc.ExpectError && len(errors) > 0 {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, errors)
+		}
+	}
+}
+
+func generateTestCertificatePEM(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName: "hello-world",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certificate,
+	}))
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func ManagedHardwareSecurityModuleName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3-24 chars, start with a letter, end with a letter or digit and may only contain alphanumeric characters and dashes", k))
+	}
+
+	if strings.Contains(value, "--") {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive dashes", k))
+	}
+
+	return warnings, errors
+}
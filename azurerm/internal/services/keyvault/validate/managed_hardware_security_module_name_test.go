@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestManagedHardwareSecurityModuleName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "hi",
+			ExpectError: true,
+		},
+		{
+			Input:       "hsm",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello-world",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello-world-21",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello--world",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello-world-",
+			ExpectError: true,
+		},
+		{
+			Input:       "1hello-world",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello_world",
+			ExpectError: true,
+		},
+		{
+			Input:       "abcdefghijklmnopqrstuvwx",
+			ExpectError: false,
+		},
+		{
+			Input:       "abcdefghijklmnopqrstuvwxy",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ManagedHardwareSecurityModuleName(tc.Input, "name")
+
+		hasError := len(errors) > 0
+		if tc.ExpectError && !hasError {
+			t.Fatalf("Expected the Managed Hardware Security Module Name to trigger a validation error for '%s'", tc.Input)
+		}
+		if !tc.ExpectError && hasError {
+			t.Fatalf("Expected the Managed Hardware Security Module Name not to trigger a validation error for '%s': %+v", tc.Input, errors)
+		}
+	}
+}
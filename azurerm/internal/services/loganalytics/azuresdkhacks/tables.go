@@ -0,0 +1,120 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// The Plan of a Table (and whether its retention is inherited from the Workspace) only exists from
+// 2021-12-01-preview onwards, which the vendored SDK doesn't include. Since reading the Plan back from an older
+// API version isn't possible, the Table resource talks to 2021-12-01-preview for every operation - reusing the
+// request building from the SDK's 2020-08-01 client, with the API version and models swapped out.
+const tablesAPIVersion = "2021-12-01-preview"
+
+type TablesWorkaroundClient struct {
+	sdkClient *operationalinsights.TablesClient
+}
+
+func NewTablesWorkaroundClient(client *operationalinsights.TablesClient) TablesWorkaroundClient {
+	return TablesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type Table struct {
+	autorest.Response `json:"-"`
+	ID                *string          `json:"id,omitempty"`
+	Name              *string          `json:"name,omitempty"`
+	Type              *string          `json:"type,omitempty"`
+	Properties        *TableProperties `json:"properties,omitempty"`
+}
+
+type TableProperties struct {
+	// RetentionInDays is always sent, since the API only resets the retention to that of the Workspace
+	// when it's explicitly `null`
+	RetentionInDays          *int32  `json:"retentionInDays"`
+	RetentionInDaysAsDefault *bool   `json:"retentionInDaysAsDefault,omitempty"`
+	Plan                     *string `json:"plan,omitempty"`
+}
+
+// Get retrieves a Log Analytics Table.
+func (client TablesWorkaroundClient) Get(ctx context.Context, resourceGroupName string, workspaceName string, tableName string) (result Table, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, workspaceName, tableName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = autorest.Prepare(req, autorest.WithQueryParameters(map[string]interface{}{
+		"api-version": tablesAPIVersion,
+	}))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Update updates a Log Analytics Table and waits for the operation to complete.
+func (client TablesWorkaroundClient) Update(ctx context.Context, resourceGroupName string, workspaceName string, tableName string, parameters Table) (result autorest.Response, err error) {
+	req, err := client.sdkClient.UpdatePreparer(ctx, resourceGroupName, workspaceName, tableName, operationalinsights.Table{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Update", nil, "Failure preparing request")
+		return result, err
+	}
+
+	parameters.ID = nil
+	parameters.Name = nil
+	parameters.Type = nil
+	if parameters.Properties != nil {
+		parameters.Properties.RetentionInDaysAsDefault = nil
+	}
+	req, err = autorest.Prepare(req,
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": tablesAPIVersion,
+		}))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Update", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.UpdateSender(req)
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Update", resp, "Failure sending request")
+		return result, err
+	}
+
+	// unlike 2020-08-01, updating a Table is a long running operation in this API version
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.TablesClient", "Update", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, future.WaitForCompletionRef(ctx, client.sdkClient.Client)
+}
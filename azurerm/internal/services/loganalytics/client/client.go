@@ -16,6 +16,7 @@ type Client struct {
 	SharedKeysClient           *operationalinsights.SharedKeysClient
 	SolutionsClient            *operationsmanagement.SolutionsClient
 	StorageInsightsClient      *operationalinsights.StorageInsightConfigsClient
+	TablesClient               *operationalinsights.TablesClient
 	WorkspacesClient           *operationalinsights.WorkspacesClient
 }
 
@@ -50,6 +51,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	LinkedStorageAccountClient := operationalinsights.NewLinkedStorageAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedStorageAccountClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := operationalinsights.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ClusterClient:              &ClusterClient,
 		DataExportClient:           &DataExportClient,
@@ -60,6 +64,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SharedKeysClient:           &SharedKeysClient,
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
+		TablesClient:               &TablesClient,
 		WorkspacesClient:           &WorkspacesClient,
 	}
 }
@@ -0,0 +1,238 @@
+package loganalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// logAnalyticsDataSourceDefinition describes a single Kind of Log Analytics Data Source, from which
+// a Resource is built by resourceLogAnalyticsDataSource - since the API is the same for every Kind and
+// only the (untyped) properties differ
+type logAnalyticsDataSourceDefinition struct {
+	// ResourceType is the name of the Terraform Resource, e.g. `azurerm_log_analytics_datasource_linux_syslog`
+	ResourceType string
+
+	// DisplayName is the human readable name of this Kind of Data Source, used in error messages
+	DisplayName string
+
+	// Kind is the Kind of Data Source managed by this Resource
+	Kind operationalinsights.DataSourceKind
+
+	// Schema contains the fields specific to this Kind of Data Source
+	Schema map[string]*schema.Schema
+
+	// Expand returns the properties for the Data Source from the fields defined in Schema
+	Expand func(d *schema.ResourceData) (map[string]interface{}, error)
+
+	// Flatten sets the fields defined in Schema from the properties of the Data Source
+	Flatten func(d *schema.ResourceData, properties map[string]interface{}) error
+}
+
+func resourceLogAnalyticsDataSource(definition logAnalyticsDataSourceDefinition) *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"workspace_name": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     validate.LogAnalyticsWorkspaceName,
+		},
+	}
+	updatable := false
+	for k, v := range definition.Schema {
+		resourceSchema[k] = v
+		updatable = updatable || !v.ForceNew
+	}
+
+	resource := &schema.Resource{
+		Create: resourceLogAnalyticsDataSourceCreateUpdate(definition),
+		Read:   resourceLogAnalyticsDataSourceRead(definition),
+		Delete: resourceLogAnalyticsDataSourceDelete(definition),
+
+		Importer: azSchema.ValidateResourceIDPriorToImportThen(func(id string) error {
+			_, err := parse.LogAnalyticsDataSourceID(id)
+			return err
+		}, importLogAnalyticsDataSource(definition.Kind)),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+
+	// some Kinds of Data Source can only be recreated, in which case there's nothing to update
+	if updatable {
+		resource.Update = resourceLogAnalyticsDataSourceCreateUpdate(definition)
+	} else {
+		resource.Timeouts.Update = nil
+	}
+
+	return resource
+}
+
+func resourceLogAnalyticsDataSourceCreateUpdate(definition logAnalyticsDataSourceDefinition) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+		ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		name := d.Get("name").(string)
+		resourceGroup := d.Get("resource_group_name").(string)
+		workspaceName := d.Get("workspace_name").(string)
+
+		if d.IsNewResource() {
+			existing, err := client.Get(ctx, resourceGroup, workspaceName, name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing Log Analytics DataSource %s %q (Resource Group %q / Workspace %q): %+v", definition.DisplayName, name, resourceGroup, workspaceName, err)
+				}
+			}
+
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return tf.ImportAsExistsError(definition.ResourceType, *existing.ID)
+			}
+		}
+
+		properties, err := definition.Expand(d)
+		if err != nil {
+			return err
+		}
+
+		parameters := operationalinsights.DataSource{
+			Kind:       definition.Kind,
+			Properties: properties,
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, resourceGroup, workspaceName, name, parameters); err != nil {
+			return fmt.Errorf("creating/updating Log Analytics DataSource %s %q (Resource Group %q / Workspace %q): %+v", definition.DisplayName, name, resourceGroup, workspaceName, err)
+		}
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Log Analytics DataSource %s %q (Resource Group %q / Workspace %q): %+v", definition.DisplayName, name, resourceGroup, workspaceName, err)
+		}
+
+		if resp.ID == nil || *resp.ID == "" {
+			return fmt.Errorf("empty or nil ID returned for Log Analytics DataSource %s %q (Resource Group %q / Workspace %q)", definition.DisplayName, name, resourceGroup, workspaceName)
+		}
+
+		d.SetId(*resp.ID)
+
+		return resourceLogAnalyticsDataSourceRead(definition)(d, meta)
+	}
+}
+
+func resourceLogAnalyticsDataSourceRead(definition logAnalyticsDataSourceDefinition) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := parse.LogAnalyticsDataSourceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Workspace, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Log Analytics DataSource %s %q was not found in Resource Group %q in Workspace %q - removing from state", definition.DisplayName, id.Name, id.ResourceGroup, id.Workspace)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Log Analytics DataSource %s %q (Resource Group %q / Workspace %q): %+v", definition.DisplayName, id.Name, id.ResourceGroup, id.Workspace, err)
+		}
+
+		d.Set("name", resp.Name)
+		d.Set("resource_group_name", id.ResourceGroup)
+		d.Set("workspace_name", id.Workspace)
+
+		properties, ok := resp.Properties.(map[string]interface{})
+		if !ok {
+			properties = make(map[string]interface{})
+		}
+		if err := definition.Flatten(d, properties); err != nil {
+			return fmt.Errorf("flattening properties for Log Analytics DataSource %s %q (Resource Group %q / Workspace %q): %+v", definition.DisplayName, id.Name, id.ResourceGroup, id.Workspace, err)
+		}
+
+		return nil
+	}
+}
+
+func resourceLogAnalyticsDataSourceDelete(definition logAnalyticsDataSourceDefinition) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+		ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := parse.LogAnalyticsDataSourceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.Delete(ctx, id.ResourceGroup, id.Workspace, id.Name); err != nil {
+			return fmt.Errorf("deleting Log Analytics DataSource %s %q (Resource Group %q / Workspace %q): %+v", definition.DisplayName, id.Name, id.ResourceGroup, id.Workspace, err)
+		}
+
+		return nil
+	}
+}
+
+// the properties are returned as untyped JSON, these helpers read values out of them
+
+func logAnalyticsDataSourcePropertyString(properties map[string]interface{}, key string) string {
+	if v, ok := properties[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func logAnalyticsDataSourcePropertyInt(properties map[string]interface{}, key string) int {
+	// numbers are decoded into a float64 from JSON
+	if v, ok := properties[key].(float64); ok {
+		return int(v)
+	}
+	return 0
+}
+
+func logAnalyticsDataSourcePropertyList(properties map[string]interface{}, key string) []interface{} {
+	if v, ok := properties[key].([]interface{}); ok {
+		return v
+	}
+	return make([]interface{}, 0)
+}
+
+func logAnalyticsDataSourcePropertyMap(properties map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := properties[key].(map[string]interface{}); ok {
+		return v
+	}
+	return make(map[string]interface{})
+}
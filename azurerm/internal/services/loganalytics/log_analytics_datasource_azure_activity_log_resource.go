@@ -0,0 +1,41 @@
+package loganalytics
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLogAnalyticsDataSourceAzureActivityLog() *schema.Resource {
+	return resourceLogAnalyticsDataSource(logAnalyticsDataSourceDefinition{
+		ResourceType: "azurerm_log_analytics_datasource_azure_activity_log",
+		DisplayName:  "Azure Activity Log",
+		Kind:         operationalinsights.AzureActivityLog,
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+		Expand: func(d *schema.ResourceData) (map[string]interface{}, error) {
+			return map[string]interface{}{
+				"linkedResourceId": fmt.Sprintf("/subscriptions/%s/providers/microsoft.insights/eventtypes/management", d.Get("subscription_id").(string)),
+			}, nil
+		},
+		Flatten: func(d *schema.ResourceData, properties map[string]interface{}) error {
+			// the linked resource is in the format `/subscriptions/{subscriptionId}/providers/microsoft.insights/eventtypes/management`
+			subscriptionId := ""
+			segments := strings.Split(strings.TrimPrefix(logAnalyticsDataSourcePropertyString(properties, "linkedResourceId"), "/"), "/")
+			if len(segments) > 1 && strings.EqualFold(segments[0], "subscriptions") {
+				subscriptionId = segments[1]
+			}
+			d.Set("subscription_id", subscriptionId)
+			return nil
+		},
+	})
+}
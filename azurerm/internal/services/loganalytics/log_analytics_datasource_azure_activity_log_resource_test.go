@@ -0,0 +1,110 @@
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type LogAnalyticsDataSourceAzureActivityLogResource struct {
+}
+
+func TestAccLogAnalyticsDataSourceAzureActivityLog_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_azure_activity_log", "test")
+	r := LogAnalyticsDataSourceAzureActivityLogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsDataSourceAzureActivityLog_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_azure_activity_log", "test")
+	r := LogAnalyticsDataSourceAzureActivityLogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t LogAnalyticsDataSourceAzureActivityLogResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.LogAnalyticsDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.DataSourcesClient.Get(ctx, id.ResourceGroup, id.Workspace, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading Log Analytics Data Source Azure Activity Log (%s): %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r LogAnalyticsDataSourceAzureActivityLogResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_azure_activity_log" "test" {
+  name                = "acctestLADS-AAL-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+  subscription_id     = data.azurerm_client_config.current.subscription_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsDataSourceAzureActivityLogResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_azure_activity_log" "import" {
+  name                = azurerm_log_analytics_datasource_azure_activity_log.test.name
+  resource_group_name = azurerm_log_analytics_datasource_azure_activity_log.test.resource_group_name
+  workspace_name      = azurerm_log_analytics_datasource_azure_activity_log.test.workspace_name
+  subscription_id     = azurerm_log_analytics_datasource_azure_activity_log.test.subscription_id
+}
+`, r.basic(data))
+}
+
+func (LogAnalyticsDataSourceAzureActivityLogResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-la-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
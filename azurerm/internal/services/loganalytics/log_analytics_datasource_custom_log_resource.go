@@ -0,0 +1,135 @@
+package loganalytics
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLogAnalyticsDataSourceCustomLog() *schema.Resource {
+	return resourceLogAnalyticsDataSource(logAnalyticsDataSourceDefinition{
+		ResourceType: "azurerm_log_analytics_datasource_custom_log",
+		DisplayName:  "Custom Log",
+		Kind:         operationalinsights.CustomLog,
+		Schema: map[string]*schema.Schema{
+			// the name of the table the records are written to
+			"custom_log_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9_]+_CL$`),
+					"must only contain alphanumeric characters and underscores, and end with `_CL`",
+				),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"linux_file_paths": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"linux_file_paths", "windows_file_paths"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"windows_file_paths": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"linux_file_paths", "windows_file_paths"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			// records are delimited by a new line unless a timestamp pattern is specified
+			"record_delimiter_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      `\n`,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		Expand:  expandLogAnalyticsDataSourceCustomLog,
+		Flatten: flattenLogAnalyticsDataSourceCustomLog,
+	})
+}
+
+func expandLogAnalyticsDataSourceCustomLog(d *schema.ResourceData) (map[string]interface{}, error) {
+	input := map[string]interface{}{
+		"location": map[string]interface{}{
+			"fileSystemLocations": map[string]interface{}{
+				"linuxFileTypeLogPaths":   d.Get("linux_file_paths").(*schema.Set).List(),
+				"windowsFileTypeLogPaths": d.Get("windows_file_paths").(*schema.Set).List(),
+			},
+		},
+		"recordDelimiter": map[string]interface{}{
+			"regexDelimiter": map[string]interface{}{
+				"pattern":    d.Get("record_delimiter_pattern").(string),
+				"matchIndex": 0,
+			},
+		},
+	}
+
+	properties := map[string]interface{}{
+		"customLogName": d.Get("custom_log_name").(string),
+		"inputs":        []interface{}{input},
+		// the time the record was collected is used as the `TimeGenerated` for the record
+		"extractions": []interface{}{
+			map[string]interface{}{
+				"extractionName": "TimeGenerated",
+				"extractionType": "DateTime",
+				"extractionProperties": map[string]interface{}{
+					"dateTimeExtraction": map[string]interface{}{},
+				},
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		properties["description"] = v.(string)
+	}
+
+	return properties, nil
+}
+
+func flattenLogAnalyticsDataSourceCustomLog(d *schema.ResourceData, properties map[string]interface{}) error {
+	d.Set("custom_log_name", logAnalyticsDataSourcePropertyString(properties, "customLogName"))
+	d.Set("description", logAnalyticsDataSourcePropertyString(properties, "description"))
+
+	linuxFilePaths := make([]interface{}, 0)
+	windowsFilePaths := make([]interface{}, 0)
+	recordDelimiterPattern := ""
+	if inputs := logAnalyticsDataSourcePropertyList(properties, "inputs"); len(inputs) > 0 {
+		if input, ok := inputs[0].(map[string]interface{}); ok {
+			location := logAnalyticsDataSourcePropertyMap(input, "location")
+			fileSystemLocations := logAnalyticsDataSourcePropertyMap(location, "fileSystemLocations")
+			linuxFilePaths = logAnalyticsDataSourcePropertyList(fileSystemLocations, "linuxFileTypeLogPaths")
+			windowsFilePaths = logAnalyticsDataSourcePropertyList(fileSystemLocations, "windowsFileTypeLogPaths")
+
+			recordDelimiter := logAnalyticsDataSourcePropertyMap(input, "recordDelimiter")
+			regexDelimiter := logAnalyticsDataSourcePropertyMap(recordDelimiter, "regexDelimiter")
+			recordDelimiterPattern = logAnalyticsDataSourcePropertyString(regexDelimiter, "pattern")
+		}
+	}
+
+	if err := d.Set("linux_file_paths", linuxFilePaths); err != nil {
+		return fmt.Errorf("setting `linux_file_paths`: %+v", err)
+	}
+	if err := d.Set("windows_file_paths", windowsFilePaths); err != nil {
+		return fmt.Errorf("setting `windows_file_paths`: %+v", err)
+	}
+	d.Set("record_delimiter_pattern", recordDelimiterPattern)
+
+	return nil
+}
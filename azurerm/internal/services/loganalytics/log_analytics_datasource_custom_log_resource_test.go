@@ -0,0 +1,156 @@
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type LogAnalyticsDataSourceCustomLogResource struct {
+}
+
+func TestAccLogAnalyticsDataSourceCustomLog_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_custom_log", "test")
+	r := LogAnalyticsDataSourceCustomLogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsDataSourceCustomLog_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_custom_log", "test")
+	r := LogAnalyticsDataSourceCustomLogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsDataSourceCustomLog_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_custom_log", "test")
+	r := LogAnalyticsDataSourceCustomLogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t LogAnalyticsDataSourceCustomLogResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.LogAnalyticsDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.DataSourcesClient.Get(ctx, id.ResourceGroup, id.Workspace, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading Log Analytics Data Source Custom Log (%s): %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r LogAnalyticsDataSourceCustomLogResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_custom_log" "test" {
+  name                = "acctestLADS-CL-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+  custom_log_name     = "acctest%d_CL"
+  linux_file_paths    = ["/var/log/acctest/*.log"]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r LogAnalyticsDataSourceCustomLogResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_custom_log" "test" {
+  name                     = "acctestLADS-CL-%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  workspace_name           = azurerm_log_analytics_workspace.test.name
+  custom_log_name          = "acctest%d_CL"
+  description              = "Acceptance Test Custom Log"
+  linux_file_paths         = ["/var/log/acctest/*.log"]
+  windows_file_paths       = ["C:\\acctest\\*.log"]
+  record_delimiter_pattern = "\\n"
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r LogAnalyticsDataSourceCustomLogResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_custom_log" "import" {
+  name                = azurerm_log_analytics_datasource_custom_log.test.name
+  resource_group_name = azurerm_log_analytics_datasource_custom_log.test.resource_group_name
+  workspace_name      = azurerm_log_analytics_datasource_custom_log.test.workspace_name
+  custom_log_name     = azurerm_log_analytics_datasource_custom_log.test.custom_log_name
+  linux_file_paths    = azurerm_log_analytics_datasource_custom_log.test.linux_file_paths
+}
+`, r.basic(data))
+}
+
+func (LogAnalyticsDataSourceCustomLogResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-la-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,43 @@
+package loganalytics
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	logAnalyticsDataSourceIISLogsEnabled  = "OnPremiseEnabled"
+	logAnalyticsDataSourceIISLogsDisabled = "OnPremiseDisabled"
+)
+
+func resourceLogAnalyticsDataSourceIISLogs() *schema.Resource {
+	return resourceLogAnalyticsDataSource(logAnalyticsDataSourceDefinition{
+		ResourceType: "azurerm_log_analytics_datasource_iis_logs",
+		DisplayName:  "IIS Logs",
+		Kind:         operationalinsights.IISLogs,
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+		Expand: func(d *schema.ResourceData) (map[string]interface{}, error) {
+			state := logAnalyticsDataSourceIISLogsDisabled
+			if d.Get("enabled").(bool) {
+				state = logAnalyticsDataSourceIISLogsEnabled
+			}
+
+			return map[string]interface{}{
+				"state": state,
+			}, nil
+		},
+		Flatten: func(d *schema.ResourceData, properties map[string]interface{}) error {
+			state := logAnalyticsDataSourcePropertyString(properties, "state")
+			d.Set("enabled", strings.EqualFold(state, logAnalyticsDataSourceIISLogsEnabled))
+			return nil
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package loganalytics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLogAnalyticsDataSourceLinuxPerformanceCounter() *schema.Resource {
+	return resourceLogAnalyticsDataSource(logAnalyticsDataSourceDefinition{
+		ResourceType: "azurerm_log_analytics_datasource_linux_performance_counter",
+		DisplayName:  "Linux Performance Counter",
+		Kind:         operationalinsights.LinuxPerformanceObject,
+		Schema: map[string]*schema.Schema{
+			"object_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"instance_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"interval_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(10, math.MaxInt32),
+			},
+
+			"counter_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+		Expand: expandLogAnalyticsDataSourceLinuxPerformanceCounter,
+		Flatten: func(d *schema.ResourceData, properties map[string]interface{}) error {
+			d.Set("object_name", logAnalyticsDataSourcePropertyString(properties, "objectName"))
+			d.Set("instance_name", logAnalyticsDataSourcePropertyString(properties, "instanceName"))
+			d.Set("interval_seconds", logAnalyticsDataSourcePropertyInt(properties, "intervalSeconds"))
+
+			counterNames := make([]interface{}, 0)
+			for _, raw := range logAnalyticsDataSourcePropertyList(properties, "performanceCounters") {
+				if v, ok := raw.(map[string]interface{}); ok {
+					counterNames = append(counterNames, logAnalyticsDataSourcePropertyString(v, "counterName"))
+				}
+			}
+			if err := d.Set("counter_names", counterNames); err != nil {
+				return fmt.Errorf("setting `counter_names`: %+v", err)
+			}
+
+			return nil
+		},
+	})
+}
+
+func expandLogAnalyticsDataSourceLinuxPerformanceCounter(d *schema.ResourceData) (map[string]interface{}, error) {
+	counters := make([]interface{}, 0)
+	for _, counterName := range d.Get("counter_names").(*schema.Set).List() {
+		counters = append(counters, map[string]interface{}{
+			"counterName": counterName.(string),
+		})
+	}
+
+	return map[string]interface{}{
+		"objectName":          d.Get("object_name").(string),
+		"instanceName":        d.Get("instance_name").(string),
+		"intervalSeconds":     d.Get("interval_seconds").(int),
+		"performanceCounters": counters,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type LogAnalyticsDataSourceLinuxPerformanceCounterResource struct {
+}
+
+func TestAccLogAnalyticsDataSourceLinuxPerformanceCounter_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_linux_performance_counter", "test")
+	r := LogAnalyticsDataSourceLinuxPerformanceCounterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsDataSourceLinuxPerformanceCounter_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_linux_performance_counter", "test")
+	r := LogAnalyticsDataSourceLinuxPerformanceCounterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsDataSourceLinuxPerformanceCounter_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_datasource_linux_performance_counter", "test")
+	r := LogAnalyticsDataSourceLinuxPerformanceCounterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t LogAnalyticsDataSourceLinuxPerformanceCounterResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.LogAnalyticsDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.DataSourcesClient.Get(ctx, id.ResourceGroup, id.Workspace, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading Log Analytics Data Source Linux Performance Counter (%s): %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r LogAnalyticsDataSourceLinuxPerformanceCounterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_linux_performance_counter" "test" {
+  name                = "acctestLADS-LPC-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+  object_name         = "Logical Disk"
+  instance_name       = "*"
+  interval_seconds    = 10
+  counter_names       = ["%% Used Space"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsDataSourceLinuxPerformanceCounterResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_linux_performance_counter" "test" {
+  name                = "acctestLADS-LPC-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+  object_name         = "Memory"
+  instance_name       = "*"
+  interval_seconds    = 60
+  counter_names       = ["Available MBytes Memory", "%% Used Memory"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsDataSourceLinuxPerformanceCounterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_datasource_linux_performance_counter" "import" {
+  name                = azurerm_log_analytics_datasource_linux_performance_counter.test.name
+  resource_group_name = azurerm_log_analytics_datasource_linux_performance_counter.test.resource_group_name
+  workspace_name      = azurerm_log_analytics_datasource_linux_performance_counter.test.workspace_name
+  object_name         = azurerm_log_analytics_datasource_linux_performance_counter.test.object_name
+  instance_name       = azurerm_log_analytics_datasource_linux_performance_counter.test.instance_name
+  interval_seconds    = azurerm_log_analytics_datasource_linux_performance_counter.test.interval_seconds
+  counter_names       = azurerm_log_analytics_datasource_linux_performance_counter.test.counter_names
+}
+`, r.basic(data))
+}
+
+func (LogAnalyticsDataSourceLinuxPerformanceCounterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-la-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,73 @@
+package loganalytics
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLogAnalyticsDataSourceLinuxSyslog() *schema.Resource {
+	return resourceLogAnalyticsDataSource(logAnalyticsDataSourceDefinition{
+		ResourceType: "azurerm_log_analytics_datasource_linux_syslog",
+		DisplayName:  "Linux Syslog",
+		Kind:         operationalinsights.LinuxSyslog,
+		Schema: map[string]*schema.Schema{
+			"syslog_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"severities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"emerg",
+						"alert",
+						"crit",
+						"err",
+						"warning",
+						"notice",
+						"info",
+						"debug",
+					}, false),
+				},
+			},
+		},
+		Expand: expandLogAnalyticsDataSourceLinuxSyslog,
+		Flatten: func(d *schema.ResourceData, properties map[string]interface{}) error {
+			d.Set("syslog_name", logAnalyticsDataSourcePropertyString(properties, "syslogName"))
+
+			severities := make([]interface{}, 0)
+			for _, raw := range logAnalyticsDataSourcePropertyList(properties, "syslogSeverities") {
+				if v, ok := raw.(map[string]interface{}); ok {
+					severities = append(severities, logAnalyticsDataSourcePropertyString(v, "severity"))
+				}
+			}
+			if err := d.Set("severities", severities); err != nil {
+				return fmt.Errorf("setting `severities`: %+v", err)
+			}
+
+			return nil
+		},
+	})
+}
+
+func expandLogAnalyticsDataSourceLinuxSyslog(d *schema.ResourceData) (map[string]interface{}, error) {
+	severities := make([]interface{}, 0)
+	for _, severity := range d.Get("severities").(*schema.Set).List() {
+		severities = append(severities, map[string]interface{}{
+			"severity": severity.(string),
+		})
+	}
+
+	return map[string]interface{}{
+		"syslogName":       d.Get("syslog_name").(string),
+		"syslogSeverities": severities,
+	}, nil
+}
@@ -0,0 +1,196 @@
+package loganalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceLogAnalyticsWorkspaceTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.LogAnalyticsWorkspaceTableID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"plan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Analytics",
+					"Basic",
+				}, false),
+			},
+
+			"retention_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(30, 730),
+			},
+		},
+
+		CustomizeDiff: func(d *schema.ResourceDiff, _ interface{}) error {
+			// the retention of a Table using the Basic plan is fixed, so it can't be configured
+			if d.Get("plan").(string) == "Basic" && d.Get("retention_in_days").(int) != 0 {
+				return fmt.Errorf("`retention_in_days` cannot be specified when `plan` is `Basic`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewTablesWorkaroundClient(meta.(*clients.Client).LogAnalytics.TablesClient)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogAnalyticsWorkspaceTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, d.Get("name").(string))
+
+	// Tables are created by the Workspace as data is ingested, so rather than being created this manages the
+	// settings of an existing Table - as such there's no requires import check since the Table always exists
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName)
+		if err != nil {
+			if utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("%s was not found", id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	parameters := azuresdkhacks.Table{
+		Properties: &azuresdkhacks.TableProperties{},
+	}
+
+	if v, ok := d.GetOk("plan"); ok {
+		parameters.Properties.Plan = utils.String(v.(string))
+	}
+
+	// when unset the retention is sent as `null`, which resets the Table to use the retention of the Workspace
+	if v, ok := d.GetOk("retention_in_days"); ok {
+		parameters.Properties.RetentionInDays = utils.Int32(int32(v.(int)))
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewTablesWorkaroundClient(meta.(*clients.Client).LogAnalytics.TablesClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogAnalyticsWorkspaceTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	plan := ""
+	retentionInDays := 0
+	if props := resp.Properties; props != nil {
+		if props.Plan != nil {
+			plan = *props.Plan
+		}
+
+		// the retention is only configurable when it isn't inherited from the Workspace (or fixed by the Basic plan)
+		inherited := props.RetentionInDaysAsDefault != nil && *props.RetentionInDaysAsDefault
+		if props.RetentionInDays != nil && !inherited && plan != "Basic" {
+			retentionInDays = int(*props.RetentionInDays)
+		}
+	}
+	d.Set("plan", plan)
+	d.Set("retention_in_days", retentionInDays)
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewTablesWorkaroundClient(meta.(*clients.Client).LogAnalytics.TablesClient)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogAnalyticsWorkspaceTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Tables can't be deleted, so instead the Table is reset to the Analytics plan and the retention of the Workspace
+	parameters := azuresdkhacks.Table{
+		Properties: &azuresdkhacks.TableProperties{
+			Plan:            utils.String("Analytics"),
+			RetentionInDays: nil,
+		},
+	}
+	if resp, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName, parameters); err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("resetting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -30,6 +30,11 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azurerm_log_analytics_cluster":                                resourceLogAnalyticsCluster(),
 		"azurerm_log_analytics_cluster_customer_managed_key":           resourceLogAnalyticsClusterCustomerManagedKey(),
+		"azurerm_log_analytics_datasource_azure_activity_log":          resourceLogAnalyticsDataSourceAzureActivityLog(),
+		"azurerm_log_analytics_datasource_custom_log":                  resourceLogAnalyticsDataSourceCustomLog(),
+		"azurerm_log_analytics_datasource_iis_logs":                    resourceLogAnalyticsDataSourceIISLogs(),
+		"azurerm_log_analytics_datasource_linux_performance_counter":   resourceLogAnalyticsDataSourceLinuxPerformanceCounter(),
+		"azurerm_log_analytics_datasource_linux_syslog":                resourceLogAnalyticsDataSourceLinuxSyslog(),
 		"azurerm_log_analytics_datasource_windows_event":               resourceLogAnalyticsDataSourceWindowsEvent(),
 		"azurerm_log_analytics_datasource_windows_performance_counter": resourceLogAnalyticsDataSourceWindowsPerformanceCounter(),
 		"azurerm_log_analytics_data_export_rule":                       resourceLogAnalyticsDataExport(),
@@ -39,5 +44,6 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_log_analytics_solution":                               resourceLogAnalyticsSolution(),
 		"azurerm_log_analytics_storage_insights":                       resourceLogAnalyticsStorageInsights(),
 		"azurerm_log_analytics_workspace":                              resourceLogAnalyticsWorkspace(),
+		"azurerm_log_analytics_workspace_table":                        resourceLogAnalyticsWorkspaceTable(),
 	}
 }
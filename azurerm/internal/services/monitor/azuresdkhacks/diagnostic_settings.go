@@ -0,0 +1,166 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Log Category Groups (e.g. `allLogs`) and Marketplace Partner destinations were introduced to Diagnostic
+// Settings in 2021-05-01-preview, long after the Monitor API versions in the vendored SDK. Since a Diagnostic
+// Setting using either of them can't be round-tripped through the older API, the Diagnostic Setting resource
+// creates, reads and deletes them exclusively through this client - the SDK is only used to build the request.
+const diagnosticSettingsAPIVersion = "2021-05-01-preview"
+
+type DiagnosticSettingsWorkaroundClient struct {
+	sdkClient *insights.DiagnosticSettingsClient
+}
+
+func NewDiagnosticSettingsWorkaroundClient(client *insights.DiagnosticSettingsClient) DiagnosticSettingsWorkaroundClient {
+	return DiagnosticSettingsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type DiagnosticSettingsResource struct {
+	autorest.Response `json:"-"`
+	ID                *string             `json:"id,omitempty"`
+	Name              *string             `json:"name,omitempty"`
+	Type              *string             `json:"type,omitempty"`
+	Properties        *DiagnosticSettings `json:"properties,omitempty"`
+}
+
+type DiagnosticSettings struct {
+	StorageAccountID            *string                    `json:"storageAccountId,omitempty"`
+	ServiceBusRuleID            *string                    `json:"serviceBusRuleId,omitempty"`
+	EventHubAuthorizationRuleID *string                    `json:"eventHubAuthorizationRuleId,omitempty"`
+	EventHubName                *string                    `json:"eventHubName,omitempty"`
+	Metrics                     *[]insights.MetricSettings `json:"metrics,omitempty"`
+	Logs                        *[]LogSettings             `json:"logs,omitempty"`
+	WorkspaceID                 *string                    `json:"workspaceId,omitempty"`
+	MarketplacePartnerID        *string                    `json:"marketplacePartnerId,omitempty"`
+	LogAnalyticsDestinationType *string                    `json:"logAnalyticsDestinationType,omitempty"`
+}
+
+type LogSettings struct {
+	Category        *string                   `json:"category,omitempty"`
+	CategoryGroup   *string                   `json:"categoryGroup,omitempty"`
+	Enabled         *bool                     `json:"enabled,omitempty"`
+	RetentionPolicy *insights.RetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the Diagnostic Setting for the specified Resource.
+func (client DiagnosticSettingsWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceURI string, parameters DiagnosticSettingsResource, name string) (result DiagnosticSettingsResource, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceURI, insights.DiagnosticSettingsResource{}, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, err
+	}
+
+	parameters.ID = nil
+	parameters.Name = nil
+	parameters.Type = nil
+	req, err = autorest.Prepare(req,
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": diagnosticSettingsAPIVersion,
+		}))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "CreateOrUpdate", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Get retrieves the Diagnostic Setting for the specified Resource.
+func (client DiagnosticSettingsWorkaroundClient) Get(ctx context.Context, resourceURI string, name string) (result DiagnosticSettingsResource, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceURI, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes the Diagnostic Setting for the specified Resource.
+func (client DiagnosticSettingsWorkaroundClient) Delete(ctx context.Context, resourceURI string, name string) (result autorest.Response, err error) {
+	req, err := client.sdkClient.DeletePreparer(ctx, resourceURI, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Delete", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Delete", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.DeleteSender(req)
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Delete", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.sdkClient.DeleteResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.DiagnosticSettingsClient", "Delete", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (client DiagnosticSettingsWorkaroundClient) withAPIVersion(req *http.Request) (*http.Request, error) {
+	return autorest.Prepare(req, autorest.WithQueryParameters(map[string]interface{}{
+		"api-version": diagnosticSettingsAPIVersion,
+	}))
+}
+
+func (client DiagnosticSettingsWorkaroundClient) responder(resp *http.Response) (result DiagnosticSettingsResource, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -19,6 +19,7 @@ import (
 	eventhubValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/validate"
 	logAnalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
 	logAnalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/azuresdkhacks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/validate"
 	storageParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
 	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
@@ -85,6 +86,12 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 				ValidateFunc: storageValidate.StorageAccountID,
 			},
 
+			"partner_solution_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"log_analytics_destination_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -95,14 +102,34 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 				}, false),
 			},
 
+			// `*` enables all of the Log Categories available for the Target Resource at apply time
+			"enabled_log_categories": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"log"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"log": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"enabled_log_categories"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"category": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"category_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
 						"enabled": {
@@ -177,16 +204,19 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 }
 
 func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	client := azuresdkhacks.NewDiagnosticSettingsWorkaroundClient(meta.(*clients.Client).Monitor.DiagnosticSettingsClient)
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
 
 	name := d.Get("name").(string)
 	actualResourceId := d.Get("target_resource_id").(string)
+	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
+	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, actualResourceId, name)
+		existing, err := client.Get(ctx, targetResourceId, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("Error checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %s", name, actualResourceId, err)
@@ -198,8 +228,21 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	logsRaw := d.Get("log").(*schema.Set).List()
-	logs := expandMonitorDiagnosticsSettingsLogs(logsRaw)
+	var logs []azuresdkhacks.LogSettings
+	if v, ok := d.GetOk("enabled_log_categories"); ok {
+		enabledLogCategories, err := expandMonitorDiagnosticsSettingsEnabledLogCategories(ctx, categoriesClient, targetResourceId, v.(*schema.Set).List())
+		if err != nil {
+			return fmt.Errorf("expanding `enabled_log_categories` for Monitor Diagnostics Setting %q for Resource %q: %+v", name, actualResourceId, err)
+		}
+		logs = enabledLogCategories
+	} else {
+		expandedLogs, err := expandMonitorDiagnosticsSettingsLogs(d.Get("log").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		logs = expandedLogs
+	}
+
 	metricsRaw := d.Get("metric").(*schema.Set).List()
 	metrics := expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
 
@@ -229,8 +272,8 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta i
 		return fmt.Errorf("At least one `log` or `metric` must be enabled")
 	}
 
-	properties := insights.DiagnosticSettingsResource{
-		DiagnosticSettings: &insights.DiagnosticSettings{
+	properties := azuresdkhacks.DiagnosticSettingsResource{
+		Properties: &azuresdkhacks.DiagnosticSettings{
 			Logs:    &logs,
 			Metrics: &metrics,
 		},
@@ -240,37 +283,41 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta i
 	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
 	eventHubName := d.Get("eventhub_name").(string)
 	if eventHubAuthorizationRuleId != "" {
-		properties.DiagnosticSettings.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
-		properties.DiagnosticSettings.EventHubName = utils.String(eventHubName)
+		properties.Properties.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
+		properties.Properties.EventHubName = utils.String(eventHubName)
 		valid = true
 	}
 
 	workspaceId := d.Get("log_analytics_workspace_id").(string)
 	if workspaceId != "" {
-		properties.DiagnosticSettings.WorkspaceID = utils.String(workspaceId)
+		properties.Properties.WorkspaceID = utils.String(workspaceId)
 		valid = true
 	}
 
 	storageAccountId := d.Get("storage_account_id").(string)
 	if storageAccountId != "" {
-		properties.DiagnosticSettings.StorageAccountID = utils.String(storageAccountId)
+		properties.Properties.StorageAccountID = utils.String(storageAccountId)
+		valid = true
+	}
+
+	partnerSolutionId := d.Get("partner_solution_id").(string)
+	if partnerSolutionId != "" {
+		properties.Properties.MarketplacePartnerID = utils.String(partnerSolutionId)
 		valid = true
 	}
 
 	if v := d.Get("log_analytics_destination_type").(string); v != "" {
 		if workspaceId != "" {
-			properties.DiagnosticSettings.LogAnalyticsDestinationType = &v
+			properties.Properties.LogAnalyticsDestinationType = &v
 		} else {
 			return fmt.Errorf("`log_analytics_workspace_id` must be set for `log_analytics_destination_type` to be used")
 		}
 	}
 
 	if !valid {
-		return fmt.Errorf("Either a `eventhub_authorization_rule_id`, `log_analytics_workspace_id` or `storage_account_id` must be set")
+		return fmt.Errorf("Either a `eventhub_authorization_rule_id`, `log_analytics_workspace_id`, `partner_solution_id` or `storage_account_id` must be set")
 	}
 
-	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
 	if _, err := client.CreateOrUpdate(ctx, targetResourceId, properties, name); err != nil {
 		return fmt.Errorf("Error creating Monitor Diagnostics Setting %q for Resource %q: %+v", name, actualResourceId, err)
 	}
@@ -289,7 +336,7 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	client := azuresdkhacks.NewDiagnosticSettingsWorkaroundClient(meta.(*clients.Client).Monitor.DiagnosticSettingsClient)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -314,10 +361,15 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 	d.Set("name", id.Name)
 	d.Set("target_resource_id", id.ResourceID)
 
-	d.Set("eventhub_name", resp.EventHubName)
+	props := resp.Properties
+	if props == nil {
+		props = &azuresdkhacks.DiagnosticSettings{}
+	}
+
+	d.Set("eventhub_name", props.EventHubName)
 	eventhubAuthorizationRuleId := ""
-	if resp.EventHubAuthorizationRuleID != nil && *resp.EventHubAuthorizationRuleID != "" {
-		parsedId, err := eventhubParse.NamespaceAuthorizationRuleIDInsensitively(*resp.EventHubAuthorizationRuleID)
+	if props.EventHubAuthorizationRuleID != nil && *props.EventHubAuthorizationRuleID != "" {
+		parsedId, err := eventhubParse.NamespaceAuthorizationRuleIDInsensitively(*props.EventHubAuthorizationRuleID)
 		if err != nil {
 			return err
 		}
@@ -327,8 +379,8 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 	d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
 
 	workspaceId := ""
-	if resp.WorkspaceID != nil && *resp.WorkspaceID != "" {
-		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*resp.WorkspaceID)
+	if props.WorkspaceID != nil && *props.WorkspaceID != "" {
+		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*props.WorkspaceID)
 		if err != nil {
 			return err
 		}
@@ -338,8 +390,8 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 	d.Set("log_analytics_workspace_id", workspaceId)
 
 	storageAccountId := ""
-	if resp.StorageAccountID != nil && *resp.StorageAccountID != "" {
-		parsedId, err := storageParse.StorageAccountID(*resp.StorageAccountID)
+	if props.StorageAccountID != nil && *props.StorageAccountID != "" {
+		parsedId, err := storageParse.StorageAccountID(*props.StorageAccountID)
 		if err != nil {
 			return err
 		}
@@ -348,13 +400,30 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 	}
 	d.Set("storage_account_id", storageAccountId)
 
-	d.Set("log_analytics_destination_type", resp.LogAnalyticsDestinationType)
+	partnerSolutionId := ""
+	if props.MarketplacePartnerID != nil {
+		partnerSolutionId = *props.MarketplacePartnerID
+	}
+	d.Set("partner_solution_id", partnerSolutionId)
+
+	d.Set("log_analytics_destination_type", props.LogAnalyticsDestinationType)
+
+	if v, ok := d.GetOk("enabled_log_categories"); ok {
+		enabledLogCategories := flattenMonitorDiagnosticEnabledLogCategories(v.(*schema.Set).List(), props.Logs)
+		if err := d.Set("enabled_log_categories", enabledLogCategories); err != nil {
+			return fmt.Errorf("Error setting `enabled_log_categories`: %+v", err)
+		}
 
-	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs)); err != nil {
-		return fmt.Errorf("Error setting `log`: %+v", err)
+		if err := d.Set("log", []interface{}{}); err != nil {
+			return fmt.Errorf("Error setting `log`: %+v", err)
+		}
+	} else {
+		if err := d.Set("log", flattenMonitorDiagnosticLogs(props.Logs)); err != nil {
+			return fmt.Errorf("Error setting `log`: %+v", err)
+		}
 	}
 
-	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics)); err != nil {
+	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(props.Metrics)); err != nil {
 		return fmt.Errorf("Error setting `metric`: %+v", err)
 	}
 
@@ -362,7 +431,7 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 }
 
 func resourceMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	client := azuresdkhacks.NewDiagnosticSettingsWorkaroundClient(meta.(*clients.Client).Monitor.DiagnosticSettingsClient)
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -397,7 +466,7 @@ func resourceMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *insights.DiagnosticSettingsClient, targetResourceId string, name string) resource.StateRefreshFunc {
+func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client azuresdkhacks.DiagnosticSettingsWorkaroundClient, targetResourceId string, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, targetResourceId, name)
 		if err != nil {
@@ -411,13 +480,18 @@ func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *ins
 	}
 }
 
-func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSettings {
-	results := make([]insights.LogSettings, 0)
+func expandMonitorDiagnosticsSettingsLogs(input []interface{}) ([]azuresdkhacks.LogSettings, error) {
+	results := make([]azuresdkhacks.LogSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
 		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+		if (category == "") == (categoryGroup == "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within a `log` block")
+		}
+
 		enabled := v["enabled"].(bool)
 		policiesRaw := v["retention_policy"].([]interface{})
 		var retentionPolicy *insights.RetentionPolicy
@@ -431,19 +505,92 @@ func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSet
 			}
 		}
 
-		output := insights.LogSettings{
-			Category:        utils.String(category),
+		output := azuresdkhacks.LogSettings{
 			Enabled:         utils.Bool(enabled),
 			RetentionPolicy: retentionPolicy,
 		}
+		if category != "" {
+			output.Category = utils.String(category)
+		}
+		if categoryGroup != "" {
+			output.CategoryGroup = utils.String(categoryGroup)
+		}
 
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
-func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
+func expandMonitorDiagnosticsSettingsEnabledLogCategories(ctx context.Context, client *insights.DiagnosticSettingsCategoryClient, targetResourceId string, input []interface{}) ([]azuresdkhacks.LogSettings, error) {
+	categories := make([]string, 0)
+	allCategories := false
+	for _, v := range input {
+		category := v.(string)
+		if category == "*" {
+			allCategories = true
+			continue
+		}
+		categories = append(categories, category)
+	}
+
+	if allCategories {
+		if len(categories) > 0 {
+			return nil, fmt.Errorf("no other Log Categories can be specified when `*` is used")
+		}
+
+		resp, err := client.List(ctx, targetResourceId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Diagnostics Categories: %+v", err)
+		}
+		if resp.Value != nil {
+			for _, v := range *resp.Value {
+				if v.Name == nil || v.DiagnosticSettingsCategory == nil || v.DiagnosticSettingsCategory.CategoryType != insights.Logs {
+					continue
+				}
+				categories = append(categories, *v.Name)
+			}
+		}
+
+		if len(categories) == 0 {
+			return nil, fmt.Errorf("no Log Categories are available for this Resource")
+		}
+	}
+
+	results := make([]azuresdkhacks.LogSettings, 0)
+	for _, category := range categories {
+		results = append(results, azuresdkhacks.LogSettings{
+			Category: utils.String(category),
+			Enabled:  utils.Bool(true),
+		})
+	}
+
+	return results, nil
+}
+
+func flattenMonitorDiagnosticEnabledLogCategories(configured []interface{}, input *[]azuresdkhacks.LogSettings) []interface{} {
+	enabledCategories := make([]interface{}, 0)
+	if input != nil {
+		for _, v := range *input {
+			if v.Category == nil || v.Enabled == nil || !*v.Enabled {
+				continue
+			}
+			enabledCategories = append(enabledCategories, *v.Category)
+		}
+	}
+
+	// when all Log Categories are enabled `*` is kept, so that any Log Categories which are made available for
+	// this Resource Type later on don't cause a diff
+	for _, v := range configured {
+		if v.(string) == "*" && len(enabledCategories) > 0 {
+			return []interface{}{"*"}
+		}
+	}
+
+	return enabledCategories
+}
+
+func flattenMonitorDiagnosticLogs(input *[]azuresdkhacks.LogSettings) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -456,6 +603,10 @@ func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
 			output["category"] = *v.Category
 		}
 
+		if v.CategoryGroup != nil {
+			output["category_group"] = *v.CategoryGroup
+		}
+
 		if v.Enabled != nil {
 			output["enabled"] = *v.Enabled
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/azuresdkhacks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -29,7 +30,7 @@ func TestAccMonitorDiagnosticSetting_eventhub(t *testing.T) {
 				check.That(data.ResourceName).Key("eventhub_name").Exists(),
 				check.That(data.ResourceName).Key("eventhub_authorization_rule_id").Exists(),
 				check.That(data.ResourceName).Key("log.#").HasValue("1"),
-				check.That(data.ResourceName).Key("log.782743152.category").HasValue("AuditEvent"),
+				check.That(data.ResourceName).Key("log.2862966835.category").HasValue("AuditEvent"),
 				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metric.1439188313.category").HasValue("AllMetrics"),
 			),
@@ -67,7 +68,7 @@ func TestAccMonitorDiagnosticSetting_logAnalyticsWorkspace(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("log_analytics_workspace_id").Exists(),
 				check.That(data.ResourceName).Key("log.#").HasValue("1"),
-				check.That(data.ResourceName).Key("log.782743152.category").HasValue("AuditEvent"),
+				check.That(data.ResourceName).Key("log.2862966835.category").HasValue("AuditEvent"),
 				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metric.1439188313.category").HasValue("AllMetrics"),
 			),
@@ -102,7 +103,7 @@ func TestAccMonitorDiagnosticSetting_storageAccount(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("storage_account_id").Exists(),
 				check.That(data.ResourceName).Key("log.#").HasValue("1"),
-				check.That(data.ResourceName).Key("log.782743152.category").HasValue("AuditEvent"),
+				check.That(data.ResourceName).Key("log.2862966835.category").HasValue("AuditEvent"),
 				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metric.1439188313.category").HasValue("AllMetrics"),
 			),
@@ -126,6 +127,70 @@ func TestAccMonitorDiagnosticSetting_activityLog(t *testing.T) {
 	})
 }
 
+func TestAccMonitorDiagnosticSetting_enabledLogCategoriesAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting", "test")
+	r := MonitorDiagnosticSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.enabledLogCategories(data, `"*"`),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_log_categories.#").HasValue("1"),
+				check.That(data.ResourceName).Key("log.#").HasValue("0"),
+			),
+		},
+		// `*` isn't returned by the API, so this can't be imported
+	})
+}
+
+func TestAccMonitorDiagnosticSetting_enabledLogCategoriesUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting", "test")
+	r := MonitorDiagnosticSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.enabledLogCategories(data, `"AuditEvent"`),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_log_categories.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.enabledLogCategories(data, `"*"`),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_log_categories.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.logAnalyticsWorkspace(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_log_categories.#").HasValue("0"),
+				check.That(data.ResourceName).Key("log.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDiagnosticSetting_categoryGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting", "test")
+	r := MonitorDiagnosticSettingResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.categoryGroup(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t MonitorDiagnosticSettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := monitor.ParseMonitorDiagnosticId(state.ID)
 	if err != nil {
@@ -134,7 +199,8 @@ func (t MonitorDiagnosticSettingResource) Exists(ctx context.Context, clients *c
 	actualResourceId := id.ResourceID
 	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
 
-	resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, targetResourceId, id.Name)
+	client := azuresdkhacks.NewDiagnosticSettingsWorkaroundClient(clients.Monitor.DiagnosticSettingsClient)
+	resp, err := client.Get(ctx, targetResourceId, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading diagnostic setting (%s): %+v", id, err)
 	}
@@ -537,3 +603,104 @@ resource "azurerm_monitor_diagnostic_setting" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(17))
 }
+
+func (MonitorDiagnosticSettingResource) enabledLogCategories(data acceptance.TestData, categories string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctest-DS-%[1]d"
+  target_resource_id         = azurerm_key_vault.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  enabled_log_categories     = [%[4]s]
+
+  metric {
+    category = "AllMetrics"
+
+    retention_policy {
+      enabled = false
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(17), categories)
+}
+
+func (MonitorDiagnosticSettingResource) categoryGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctest-DS-%[1]d"
+  target_resource_id         = azurerm_key_vault.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  log {
+    category_group = "allLogs"
+
+    retention_policy {
+      enabled = false
+    }
+  }
+
+  metric {
+    category = "AllMetrics"
+
+    retention_policy {
+      enabled = false
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(17))
+}
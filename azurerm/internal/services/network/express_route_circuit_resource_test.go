@@ -52,6 +52,11 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"multiple":       testAccExpressRouteCircuitAuthorization_multiple,
 			"requiresImport": testAccExpressRouteCircuitAuthorization_requiresImport,
 		},
+		"connection": {
+			"basic":          testAccExpressRouteConnection_basic,
+			"requiresImport": testAccExpressRouteConnection_requiresImport,
+			"update":         testAccExpressRouteConnection_update,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,209 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ExpressRouteConnectionResource struct {
+}
+
+func testAccExpressRouteConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccExpressRouteConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccExpressRouteConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing_weight").HasValue("2"),
+				check.That(data.ResourceName).Key("enable_internet_security").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ExpressRouteConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ExpressRouteConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ExpressRouteConnectionsClient.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (ExpressRouteConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-erconnection-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = azurerm_express_route_circuit.test.name
+  resource_group_name           = azurerm_resource_group.test.name
+  shared_key                    = "SSSSsssssshhhhhItsASecret"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 100
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+
+resource "azurerm_express_route_gateway" "test" {
+  name                = "acctest-ergw-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_hub_id      = azurerm_virtual_hub.test.id
+  scale_units         = 1
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ExpressRouteConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctest-ercon-%d"
+  express_route_gateway_id         = azurerm_express_route_gateway.test.id
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRouteConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_connection" "import" {
+  name                             = azurerm_express_route_connection.test.name
+  express_route_gateway_id         = azurerm_express_route_connection.test.express_route_gateway_id
+  express_route_circuit_peering_id = azurerm_express_route_connection.test.express_route_circuit_peering_id
+}
+`, r.basic(data))
+}
+
+func (r ExpressRouteConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_table" "test" {
+  name           = "acctest-vhubrt-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+  labels         = ["label1"]
+}
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctest-ercon-%[2]d"
+  express_route_gateway_id         = azurerm_express_route_gateway.test.id
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+  routing_weight                   = 2
+  enable_internet_security         = true
+
+  routing {
+    associated_route_table_id = azurerm_virtual_hub_route_table.test.id
+
+    propagated_route_table {
+      labels          = ["label1"]
+      route_table_ids = [azurerm_virtual_hub_route_table.test.id]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,513 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const networkVirtualApplianceResourceName = "azurerm_network_virtual_appliance"
+
+func resourceNetworkVirtualAppliance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkVirtualApplianceCreateUpdate,
+		Read:   resourceNetworkVirtualApplianceRead,
+		Update: resourceNetworkVirtualApplianceCreateUpdate,
+		Delete: resourceNetworkVirtualApplianceDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.NetworkVirtualApplianceID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vendor": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"bundled_scale_unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"market_place_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"virtual_appliance_asn": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkVirtualApplianceAsn,
+			},
+
+			"boot_strap_configuration_blobs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+
+			"cloud_init_configuration_blobs": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_init_configuration"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+
+			"cloud_init_configuration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"cloud_init_configuration_blobs"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ResourceIdentityTypeSystemAssigned),
+								string(network.ResourceIdentityTypeUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"virtual_appliance_nic": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkVirtualApplianceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkVirtualApplianceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	locks.ByName(id.Name, networkVirtualApplianceResourceName)
+	defer locks.UnlockByName(id.Name, networkVirtualApplianceResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_network_virtual_appliance", id.ID())
+		}
+	}
+
+	identity, err := expandNetworkVirtualApplianceIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VirtualAppliance{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Identity: identity,
+		VirtualAppliancePropertiesFormat: &network.VirtualAppliancePropertiesFormat{
+			NvaSku: expandNetworkVirtualApplianceSku(d.Get("sku").([]interface{})),
+			VirtualHub: &network.SubResource{
+				ID: utils.String(virtualHubId.ID()),
+			},
+			BootStrapConfigurationBlobs: utils.ExpandStringSlice(d.Get("boot_strap_configuration_blobs").([]interface{})),
+			CloudInitConfigurationBlobs: utils.ExpandStringSlice(d.Get("cloud_init_configuration_blobs").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("virtual_appliance_asn"); ok {
+		parameters.VirtualAppliancePropertiesFormat.VirtualApplianceAsn = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("cloud_init_configuration"); ok {
+		parameters.VirtualAppliancePropertiesFormat.CloudInitConfiguration = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkVirtualApplianceRead(d, meta)
+}
+
+func resourceNetworkVirtualApplianceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	identity, err := flattenNetworkVirtualApplianceIdentity(resp.Identity)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.VirtualAppliancePropertiesFormat; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			hubId, err := parse.VirtualHubID(*props.VirtualHub.ID)
+			if err != nil {
+				return err
+			}
+			virtualHubId = hubId.ID()
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if err := d.Set("sku", flattenNetworkVirtualApplianceSku(props.NvaSku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		virtualApplianceAsn := 0
+		if props.VirtualApplianceAsn != nil {
+			virtualApplianceAsn = int(*props.VirtualApplianceAsn)
+		}
+		d.Set("virtual_appliance_asn", virtualApplianceAsn)
+
+		if err := d.Set("boot_strap_configuration_blobs", utils.FlattenStringSlice(props.BootStrapConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `boot_strap_configuration_blobs`: %+v", err)
+		}
+
+		if err := d.Set("cloud_init_configuration_blobs", utils.FlattenStringSlice(props.CloudInitConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `cloud_init_configuration_blobs`: %+v", err)
+		}
+
+		if err := d.Set("virtual_appliance_nic", flattenNetworkVirtualApplianceNics(props.VirtualApplianceNics)); err != nil {
+			return fmt.Errorf("setting `virtual_appliance_nic`: %+v", err)
+		}
+	}
+
+	// `cloud_init_configuration` isn't returned from the API so we keep the value from the config
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceNetworkVirtualApplianceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	locks.ByName(id.Name, networkVirtualApplianceResourceName)
+	defer locks.UnlockByName(id.Name, networkVirtualApplianceResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkVirtualApplianceSku(input []interface{}) *network.VirtualApplianceSkuProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	return &network.VirtualApplianceSkuProperties{
+		Vendor:             utils.String(v["vendor"].(string)),
+		BundledScaleUnit:   utils.String(v["bundled_scale_unit"].(string)),
+		MarketPlaceVersion: utils.String(v["market_place_version"].(string)),
+	}
+}
+
+func flattenNetworkVirtualApplianceSku(input *network.VirtualApplianceSkuProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	vendor := ""
+	if input.Vendor != nil {
+		vendor = *input.Vendor
+	}
+
+	bundledScaleUnit := ""
+	if input.BundledScaleUnit != nil {
+		bundledScaleUnit = *input.BundledScaleUnit
+	}
+
+	marketPlaceVersion := ""
+	if input.MarketPlaceVersion != nil {
+		marketPlaceVersion = *input.MarketPlaceVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vendor":               vendor,
+			"bundled_scale_unit":   bundledScaleUnit,
+			"market_place_version": marketPlaceVersion,
+		},
+	}
+}
+
+func expandNetworkVirtualApplianceIdentity(input []interface{}) (*network.ManagedServiceIdentity, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	v := input[0].(map[string]interface{})
+
+	identityType := network.ResourceIdentityType(v["type"].(string))
+	identityIds := v["identity_ids"].(*schema.Set).List()
+
+	identity := network.ManagedServiceIdentity{
+		Type: identityType,
+	}
+
+	if identityType == network.ResourceIdentityTypeUserAssigned {
+		if len(identityIds) == 0 {
+			return nil, fmt.Errorf("`identity_ids` must be specified when `type` is set to %q", string(network.ResourceIdentityTypeUserAssigned))
+		}
+
+		userAssignedIdentities := make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
+		for _, id := range identityIds {
+			userAssignedIdentities[id.(string)] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+		}
+		identity.UserAssignedIdentities = userAssignedIdentities
+	} else if len(identityIds) > 0 {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is set to %q", string(network.ResourceIdentityTypeUserAssigned))
+	}
+
+	return &identity, nil
+}
+
+func flattenNetworkVirtualApplianceIdentity(input *network.ManagedServiceIdentity) ([]interface{}, error) {
+	if input == nil || input.Type == network.ResourceIdentityTypeNone {
+		return make([]interface{}, 0), nil
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		id, err := msiParse.UserAssignedIdentityID(key)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = append(identityIds, id.ID())
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}, nil
+}
+
+func flattenNetworkVirtualApplianceNics(input *[]network.VirtualApplianceNicProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateIPAddress := ""
+		if item.PrivateIPAddress != nil {
+			privateIPAddress = *item.PrivateIPAddress
+		}
+
+		publicIPAddress := ""
+		if item.PublicIPAddress != nil {
+			publicIPAddress = *item.PublicIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"private_ip_address": privateIPAddress,
+			"public_ip_address":  publicIPAddress,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,183 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkVirtualApplianceResource struct {
+}
+
+func TestAccNetworkVirtualAppliance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_virtual_appliance", "test")
+	r := NetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkVirtualAppliance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_virtual_appliance", "test")
+	r := NetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkVirtualAppliance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_virtual_appliance", "test")
+	r := NetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NetworkVirtualApplianceResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NetworkVirtualApplianceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualApplianceClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (NetworkVirtualApplianceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nva-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.0.0/23"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkVirtualApplianceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_virtual_appliance" "test" {
+  name                  = "acctest-nva-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  virtual_hub_id        = azurerm_virtual_hub.test.id
+  virtual_appliance_asn = 64512
+
+  sku {
+    vendor               = "barracudasdwanrelease"
+    bundled_scale_unit   = "2"
+    market_place_version = "latest"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkVirtualApplianceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_virtual_appliance" "import" {
+  name                  = azurerm_network_virtual_appliance.test.name
+  resource_group_name   = azurerm_network_virtual_appliance.test.resource_group_name
+  location              = azurerm_network_virtual_appliance.test.location
+  virtual_hub_id        = azurerm_network_virtual_appliance.test.virtual_hub_id
+  virtual_appliance_asn = azurerm_network_virtual_appliance.test.virtual_appliance_asn
+
+  sku {
+    vendor               = "barracudasdwanrelease"
+    bundled_scale_unit   = "2"
+    market_place_version = "latest"
+  }
+}
+`, r.basic(data))
+}
+
+func (r NetworkVirtualApplianceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_virtual_appliance" "test" {
+  name                  = "acctest-nva-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  virtual_hub_id        = azurerm_virtual_hub.test.id
+  virtual_appliance_asn = 64512
+
+  sku {
+    vendor               = "barracudasdwanrelease"
+    bundled_scale_unit   = "2"
+    market_place_version = "latest"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
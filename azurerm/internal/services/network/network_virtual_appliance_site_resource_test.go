@@ -0,0 +1,130 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkVirtualApplianceSiteResource struct {
+}
+
+func TestAccNetworkVirtualApplianceSite_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_virtual_appliance_site", "test")
+	r := NetworkVirtualApplianceSiteResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkVirtualApplianceSite_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_virtual_appliance_site", "test")
+	r := NetworkVirtualApplianceSiteResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkVirtualApplianceSite_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_virtual_appliance_site", "test")
+	r := NetworkVirtualApplianceSiteResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("o365_policy.0.default_endpoint_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NetworkVirtualApplianceSiteResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NetworkVirtualApplianceSiteID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualApplianceSitesClient.Get(ctx, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r NetworkVirtualApplianceSiteResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_virtual_appliance_site" "test" {
+  name                         = "acctest-nvasite-%d"
+  network_virtual_appliance_id = azurerm_network_virtual_appliance.test.id
+  address_prefix               = "10.0.1.0/24"
+}
+`, NetworkVirtualApplianceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkVirtualApplianceSiteResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_virtual_appliance_site" "import" {
+  name                         = azurerm_network_virtual_appliance_site.test.name
+  network_virtual_appliance_id = azurerm_network_virtual_appliance_site.test.network_virtual_appliance_id
+  address_prefix               = azurerm_network_virtual_appliance_site.test.address_prefix
+}
+`, r.basic(data))
+}
+
+func (r NetworkVirtualApplianceSiteResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_virtual_appliance_site" "test" {
+  name                         = "acctest-nvasite-%d"
+  network_virtual_appliance_id = azurerm_network_virtual_appliance.test.id
+  address_prefix               = "10.0.2.0/24"
+
+  o365_policy {
+    allow_endpoint_enabled    = true
+    default_endpoint_enabled  = true
+    optimize_endpoint_enabled = false
+  }
+}
+`, NetworkVirtualApplianceResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,159 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkVirtualApplianceSkus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkVirtualApplianceSkusRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"skus": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vendor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"available_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"available_scale_unit": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scale_unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"instance_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkVirtualApplianceSkusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceSkusClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	skus, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Network Virtual Appliance SKUs: %+v", err)
+	}
+
+	vendor := d.Get("vendor").(string)
+	results := make([]network.VirtualApplianceSku, 0)
+	for skus.NotDone() {
+		sku := skus.Value()
+		if vendor == "" || (sku.VirtualApplianceSkuPropertiesFormat != nil && sku.Vendor != nil && strings.EqualFold(*sku.Vendor, vendor)) {
+			results = append(results, sku)
+		}
+
+		if err := skus.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Network Virtual Appliance SKUs: %+v", err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/networkVirtualApplianceSkus", subscriptionId))
+
+	if err := d.Set("skus", flattenNetworkVirtualApplianceSkus(results)); err != nil {
+		return fmt.Errorf("setting `skus`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkVirtualApplianceSkus(input []network.VirtualApplianceSku) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		vendor := ""
+		availableVersions := make([]interface{}, 0)
+		availableScaleUnits := make([]interface{}, 0)
+		if props := item.VirtualApplianceSkuPropertiesFormat; props != nil {
+			if props.Vendor != nil {
+				vendor = *props.Vendor
+			}
+
+			availableVersions = utils.FlattenStringSlice(props.AvailableVersions)
+
+			if props.AvailableScaleUnits != nil {
+				for _, scaleUnit := range *props.AvailableScaleUnits {
+					unit := ""
+					if scaleUnit.ScaleUnit != nil {
+						unit = *scaleUnit.ScaleUnit
+					}
+
+					instanceCount := 0
+					if scaleUnit.InstanceCount != nil {
+						instanceCount = int(*scaleUnit.InstanceCount)
+					}
+
+					availableScaleUnits = append(availableScaleUnits, map[string]interface{}{
+						"scale_unit":     unit,
+						"instance_count": instanceCount,
+					})
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"vendor":               vendor,
+			"available_versions":   availableVersions,
+			"available_scale_unit": availableScaleUnits,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,63 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkVirtualApplianceSkusDataSource struct {
+}
+
+func TestAccDataSourceNetworkVirtualApplianceSkus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_virtual_appliance_skus", "test")
+	r := NetworkVirtualApplianceSkusDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkVirtualApplianceSkus_vendor(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_virtual_appliance_skus", "test")
+	r := NetworkVirtualApplianceSkusDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.vendor(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.0.name").Exists(),
+				check.That(data.ResourceName).Key("skus.0.vendor").HasValue("barracudasdwanrelease"),
+			),
+		},
+	})
+}
+
+func (NetworkVirtualApplianceSkusDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_network_virtual_appliance_skus" "test" {}
+`
+}
+
+func (NetworkVirtualApplianceSkusDataSource) vendor() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_network_virtual_appliance_skus" "test" {
+  vendor = "barracudasdwanrelease"
+}
+`
+}
@@ -29,6 +29,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                         dataSourceNetworkInterface(),
 		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
+		"azurerm_network_virtual_appliance_skus":            dataSourceNetworkVirtualApplianceSkus(),
 		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
 		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
 		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
@@ -58,6 +59,7 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_express_route_circuit_authorization": resourceExpressRouteCircuitAuthorization(),
 		"azurerm_express_route_circuit_peering":       resourceExpressRouteCircuitPeering(),
 		"azurerm_express_route_circuit":               resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":            resourceExpressRouteConnection(),
 		"azurerm_express_route_gateway":               resourceExpressRouteGateway(),
 		"azurerm_express_route_port":                  resourceArmExpressRoutePort(),
 		"azurerm_ip_group":                            resourceIpGroup(),
@@ -82,6 +84,8 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_public_ip_prefix":                                                       resourcePublicIpPrefix(),
 		"azurerm_network_security_group":                                                 resourceNetworkSecurityGroup(),
 		"azurerm_network_security_rule":                                                  resourceNetworkSecurityRule(),
+		"azurerm_network_virtual_appliance":                                              resourceNetworkVirtualAppliance(),
+		"azurerm_network_virtual_appliance_site":                                         resourceNetworkVirtualApplianceSite(),
 		"azurerm_network_watcher_flow_log":                                               resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                                                        resourceNetworkWatcher(),
 		"azurerm_route_filter":                                                           resourceRouteFilter(),
@@ -102,6 +106,8 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_virtual_network_gateway":                                                resourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_peering":                                                resourceVirtualNetworkPeering(),
 		"azurerm_virtual_network":                                                        resourceVirtualNetwork(),
+		"azurerm_virtual_router":                                                         resourceVirtualRouter(),
+		"azurerm_virtual_router_peering":                                                 resourceVirtualRouterPeering(),
 		"azurerm_virtual_wan":                                                            resourceVirtualWan(),
 		"azurerm_vpn_gateway":                                                            resourceVPNGateway(),
 		"azurerm_vpn_gateway_connection":                                                 resourceVPNGatewayConnection(),
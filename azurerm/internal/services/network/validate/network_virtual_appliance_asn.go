@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+)
+
+// NetworkVirtualApplianceAsn validates the ASN of a Network Virtual Appliance, which must be a valid
+// 16 or 32 bit ASN. This is compared as an int64 since a 32 bit ASN can overflow an `int` on 32 bit platforms.
+func NetworkVirtualApplianceAsn(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return warnings, errors
+	}
+
+	if value < 0 || int64(value) > 4294967295 {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and 4294967295, got %d", k, value))
+	}
+
+	return warnings, errors
+}
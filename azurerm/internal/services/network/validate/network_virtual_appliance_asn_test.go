@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestNetworkVirtualApplianceAsn(t *testing.T) {
+	cases := []struct {
+		Input       int64
+		ExpectError bool
+	}{
+		{
+			Input:       -1,
+			ExpectError: true,
+		},
+		{
+			Input:       0,
+			ExpectError: false,
+		},
+		{
+			Input:       65000,
+			ExpectError: false,
+		},
+		{
+			Input:       4294967295,
+			ExpectError: false,
+		},
+		{
+			Input:       4294967296,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		// 32 bit ASNs can't be represented by an `int` on 32 bit platforms
+		if int64(int(tc.Input)) != tc.Input {
+			continue
+		}
+
+		_, errors := NetworkVirtualApplianceAsn(int(tc.Input), "virtual_appliance_asn")
+
+		hasError := len(errors) > 0
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected hasError to be %t for %d but got %t", tc.ExpectError, tc.Input, hasError)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+)
+
+// VirtualRouterPeerAsn validates the ASN of a BGP peer of a Virtual Router, which must be a valid
+// 16 or 32 bit ASN and must not be one of the ASNs reserved by Azure.
+func VirtualRouterPeerAsn(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return warnings, errors
+	}
+
+	if value < 1 || int64(value) > 4294967295 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 4294967295, got %d", k, value))
+		return warnings, errors
+	}
+
+	reserved := []int{8074, 8075, 12076, 65515, 65517, 65518, 65519, 65520}
+	for _, asn := range reserved {
+		if value == asn {
+			errors = append(errors, fmt.Errorf("%q must not be one of the ASNs reserved by Azure (%v), got %d", k, reserved, value))
+			break
+		}
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,128 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualRouterPeeringResource struct {
+}
+
+func TestAccVirtualRouterPeering_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_router_peering", "test")
+	r := VirtualRouterPeeringResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualRouterPeering_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_router_peering", "test")
+	r := VirtualRouterPeeringResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualRouterPeering_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_router_peering", "test")
+	r := VirtualRouterPeeringResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("peer_asn").HasValue("65001"),
+				check.That(data.ResourceName).Key("peer_ip").HasValue("10.0.2.5"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualRouterPeeringResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.VirtualRouterPeeringID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualRouterPeeringsClient.Get(ctx, id.ResourceGroup, id.VirtualRouterName, id.PeeringName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r VirtualRouterPeeringResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_router_peering" "test" {
+  name              = "acctest-vrpeering-%d"
+  virtual_router_id = azurerm_virtual_router.test.id
+  peer_asn          = 65000
+  peer_ip           = "10.0.2.4"
+}
+`, VirtualRouterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VirtualRouterPeeringResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_router_peering" "import" {
+  name              = azurerm_virtual_router_peering.test.name
+  virtual_router_id = azurerm_virtual_router_peering.test.virtual_router_id
+  peer_asn          = azurerm_virtual_router_peering.test.peer_asn
+  peer_ip           = azurerm_virtual_router_peering.test.peer_ip
+}
+`, r.basic(data))
+}
+
+func (r VirtualRouterPeeringResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_router_peering" "test" {
+  name              = "acctest-vrpeering-%d"
+  virtual_router_id = azurerm_virtual_router.test.id
+  peer_asn          = 65001
+  peer_ip           = "10.0.2.5"
+}
+`, VirtualRouterResource{}.basic(data), data.RandomInteger)
+}
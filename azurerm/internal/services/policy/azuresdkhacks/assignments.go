@@ -0,0 +1,179 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-09-01/policy"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// None of the Policy API versions in the release of the Azure SDK for Go we vendor (the newest being
+// 2020-03-01-preview) support User Assigned Identities or Non-Compliance Messages on a Policy Assignment. Until
+// the SDK can be upgraded the scoped Policy Assignment resources create, read and delete Assignments exclusively
+// through this client against 2021-06-01 - using the SDK's 2019-09-01 client only to build the request URI.
+const assignmentsAPIVersion = "2021-06-01"
+
+type AssignmentsWorkaroundClient struct {
+	sdkClient *policy.AssignmentsClient
+}
+
+func NewAssignmentsWorkaroundClient(client *policy.AssignmentsClient) AssignmentsWorkaroundClient {
+	return AssignmentsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type Assignment struct {
+	autorest.Response `json:"-"`
+	ID                *string               `json:"id,omitempty"`
+	Type              *string               `json:"type,omitempty"`
+	Name              *string               `json:"name,omitempty"`
+	Location          *string               `json:"location,omitempty"`
+	Identity          *AssignmentIdentity   `json:"identity,omitempty"`
+	Properties        *AssignmentProperties `json:"properties,omitempty"`
+}
+
+type AssignmentIdentity struct {
+	PrincipalID            *string                                    `json:"principalId,omitempty"`
+	TenantID               *string                                    `json:"tenantId,omitempty"`
+	Type                   string                                     `json:"type,omitempty"`
+	UserAssignedIdentities map[string]*AssignmentUserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
+
+type AssignmentUserAssignedIdentity struct {
+	PrincipalID *string `json:"principalId,omitempty"`
+	ClientID    *string `json:"clientId,omitempty"`
+}
+
+type AssignmentProperties struct {
+	DisplayName           *string                                 `json:"displayName,omitempty"`
+	PolicyDefinitionID    *string                                 `json:"policyDefinitionId,omitempty"`
+	Scope                 *string                                 `json:"scope,omitempty"`
+	NotScopes             *[]string                               `json:"notScopes,omitempty"`
+	Parameters            map[string]*policy.ParameterValuesValue `json:"parameters,omitempty"`
+	Description           *string                                 `json:"description,omitempty"`
+	Metadata              interface{}                             `json:"metadata,omitempty"`
+	EnforcementMode       policy.EnforcementMode                  `json:"enforcementMode,omitempty"`
+	NonComplianceMessages *[]NonComplianceMessage                 `json:"nonComplianceMessages,omitempty"`
+}
+
+type NonComplianceMessage struct {
+	Message                     *string `json:"message,omitempty"`
+	PolicyDefinitionReferenceID *string `json:"policyDefinitionReferenceId,omitempty"`
+}
+
+// Create creates or updates a Policy Assignment.
+func (client AssignmentsWorkaroundClient) Create(ctx context.Context, scope string, policyAssignmentName string, parameters Assignment) (result Assignment, err error) {
+	req, err := client.sdkClient.CreatePreparer(ctx, scope, policyAssignmentName, policy.Assignment{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Create", nil, "Failure preparing request")
+		return result, err
+	}
+
+	parameters.ID = nil
+	parameters.Type = nil
+	parameters.Name = nil
+	req, err = autorest.Prepare(req,
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": assignmentsAPIVersion,
+		}))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Create", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.CreateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Create", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp, http.StatusCreated)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Create", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Get retrieves a Policy Assignment.
+func (client AssignmentsWorkaroundClient) Get(ctx context.Context, scope string, policyAssignmentName string) (result Assignment, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, scope, policyAssignmentName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes a Policy Assignment.
+func (client AssignmentsWorkaroundClient) Delete(ctx context.Context, scope string, policyAssignmentName string) (result Assignment, err error) {
+	req, err := client.sdkClient.DeletePreparer(ctx, scope, policyAssignmentName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Delete", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Delete", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.DeleteSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Delete", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp, http.StatusNoContent)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policy.AssignmentsClient", "Delete", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (client AssignmentsWorkaroundClient) withAPIVersion(req *http.Request) (*http.Request, error) {
+	return autorest.Prepare(req, autorest.WithQueryParameters(map[string]interface{}{
+		"api-version": assignmentsAPIVersion,
+	}))
+}
+
+func (client AssignmentsWorkaroundClient) responder(resp *http.Response, additionalStatusCodes ...int) (result Assignment, err error) {
+	statusCodes := append([]int{http.StatusOK}, additionalStatusCodes...)
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(statusCodes...),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -11,6 +11,7 @@ type Client struct {
 	DefinitionsClient    *policy.DefinitionsClient
 	SetDefinitionsClient *policy.SetDefinitionsClient
 	RemediationsClient   *policyinsights.RemediationsClient
+	PolicyStatesClient   *policyinsights.PolicyStatesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -26,10 +27,14 @@ func NewClient(o *common.ClientOptions) *Client {
 	remediationsClient := policyinsights.NewRemediationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&remediationsClient.Client, o.ResourceManagerAuthorizer)
 
+	policyStatesClient := policyinsights.NewPolicyStatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyStatesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AssignmentsClient:    &assignmentsClient,
 		DefinitionsClient:    &definitionsClient,
 		SetDefinitionsClient: &setDefinitionsClient,
 		RemediationsClient:   &remediationsClient,
+		PolicyStatesClient:   &policyStatesClient,
 	}
 }
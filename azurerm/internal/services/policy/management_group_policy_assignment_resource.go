@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	managementGroupParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+)
+
+func resourceArmManagementGroupPolicyAssignment() *schema.Resource {
+	return resourceArmPolicyAssignmentAtScope(policyAssignmentScopeDefinition{
+		ResourceType:      "azurerm_management_group_policy_assignment",
+		ScopeField:        "management_group_id",
+		ScopeValidateFunc: managementGroupValidate.ManagementGroupID,
+		BuildID: func(scopeId string, name string) (string, error) {
+			managementGroupId, err := managementGroupParse.ManagementGroupID(scopeId)
+			if err != nil {
+				return "", err
+			}
+
+			return parse.NewManagementGroupPolicyAssignmentID(managementGroupId.Name, name).ID(), nil
+		},
+		ParseID: func(input string) (*policyAssignmentAtScopeId, error) {
+			id, err := parse.ManagementGroupPolicyAssignmentID(input)
+			if err != nil {
+				return nil, err
+			}
+
+			return &policyAssignmentAtScopeId{
+				ScopeId: managementGroupParse.NewManagementGroupId(id.ManagementGroupName).ID(),
+				Name:    id.PolicyAssignmentName,
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	managementGroupParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ManagementGroupPolicyAssignmentResource struct{}
+
+func TestAccManagementGroupPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_assignment", "test")
+	r := ManagementGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_assignment", "test")
+	r := ManagementGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagementGroupPolicyAssignment_systemAssignedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_assignment", "test")
+	r := ManagementGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.systemAssignedIdentity(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagementGroupPolicyAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ManagementGroupPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentsClient := azuresdkhacks.NewAssignmentsWorkaroundClient(client.Policy.AssignmentsClient)
+	scope := managementGroupParse.NewManagementGroupId(id.ManagementGroupName).ID()
+	resp, err := assignmentsClient.Get(ctx, scope, id.PolicyAssignmentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r ManagementGroupPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]s"
+  management_group_id  = azurerm_management_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ManagementGroupPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_assignment" "import" {
+  name                 = azurerm_management_group_policy_assignment.test.name
+  management_group_id  = azurerm_management_group_policy_assignment.test.management_group_id
+  policy_definition_id = azurerm_management_group_policy_assignment.test.policy_definition_id
+}
+`, r.basic(data))
+}
+
+func (r ManagementGroupPolicyAssignmentResource) systemAssignedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]s"
+  management_group_id  = azurerm_management_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  location             = "%[3]s"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.template(data), data.RandomString, data.Locations.Primary)
+}
+
+func (r ManagementGroupPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctest-policy-%[1]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name                = "acctestpol-%[1]s"
+  policy_type         = "Custom"
+  mode                = "All"
+  display_name        = "acctestpol-%[1]s"
+  management_group_id = azurerm_management_group.test.group_id
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": ["%[2]s"]
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+}
+`, data.RandomString, data.Locations.Primary)
+}
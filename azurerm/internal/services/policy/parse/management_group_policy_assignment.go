@@ -0,0 +1,47 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type ManagementGroupPolicyAssignmentId struct {
+	ManagementGroupName  string
+	PolicyAssignmentName string
+}
+
+func NewManagementGroupPolicyAssignmentID(managementGroupName, policyAssignmentName string) ManagementGroupPolicyAssignmentId {
+	return ManagementGroupPolicyAssignmentId{
+		ManagementGroupName:  managementGroupName,
+		PolicyAssignmentName: policyAssignmentName,
+	}
+}
+
+func (id ManagementGroupPolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Management Group Name %q", id.ManagementGroupName),
+		fmt.Sprintf("Policy Assignment Name %q", id.PolicyAssignmentName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Group Policy Assignment", segmentsStr)
+}
+
+func (id ManagementGroupPolicyAssignmentId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Authorization/policyAssignments/%s"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.PolicyAssignmentName)
+}
+
+// ManagementGroupPolicyAssignmentID parses a ManagementGroupPolicyAssignment ID into an ManagementGroupPolicyAssignmentId struct
+func ManagementGroupPolicyAssignmentID(input string) (*ManagementGroupPolicyAssignmentId, error) {
+	regex := regexp.MustCompile(`^/providers/Microsoft\.Management/managementGroups/([^/]+)/providers/Microsoft\.Authorization/policyAssignments/([^/]+)$`)
+	matches := regex.FindStringSubmatch(input)
+	if len(matches) != 3 {
+		return nil, fmt.Errorf("unable to parse Management Group Policy Assignment ID %q: expected the format `/providers/Microsoft.Management/managementGroups/{managementGroupName}/providers/Microsoft.Authorization/policyAssignments/{policyAssignmentName}`", input)
+	}
+
+	return &ManagementGroupPolicyAssignmentId{
+		ManagementGroupName:  matches[1],
+		PolicyAssignmentName: matches[2],
+	}, nil
+}
@@ -0,0 +1,71 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ResourcePolicyAssignmentId struct {
+	ResourceId           string
+	PolicyAssignmentName string
+}
+
+func NewResourcePolicyAssignmentID(resourceId, policyAssignmentName string) ResourcePolicyAssignmentId {
+	return ResourcePolicyAssignmentId{
+		ResourceId:           resourceId,
+		PolicyAssignmentName: policyAssignmentName,
+	}
+}
+
+func (id ResourcePolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Resource ID %q", id.ResourceId),
+		fmt.Sprintf("Policy Assignment Name %q", id.PolicyAssignmentName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Policy Assignment", segmentsStr)
+}
+
+func (id ResourcePolicyAssignmentId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/policyAssignments/%s"
+	return fmt.Sprintf(fmtString, id.ResourceId, id.PolicyAssignmentName)
+}
+
+// ResourcePolicyAssignmentID parses a ResourcePolicyAssignment ID into an ResourcePolicyAssignmentId struct
+func ResourcePolicyAssignmentID(input string) (*ResourcePolicyAssignmentId, error) {
+	segments := strings.Split(input, "/providers/Microsoft.Authorization/policyAssignments/")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("unable to parse Resource Policy Assignment ID %q: expected the format `{resourceId}/providers/Microsoft.Authorization/policyAssignments/{policyAssignmentName}`", input)
+	}
+
+	resourceId := segments[0]
+	name := segments[1]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("unable to parse Resource Policy Assignment ID %q: expected a Policy Assignment Name", input)
+	}
+
+	if err := validateResourceScopeID(resourceId); err != nil {
+		return nil, fmt.Errorf("unable to parse Resource Policy Assignment ID %q: %+v", input, err)
+	}
+
+	return &ResourcePolicyAssignmentId{
+		ResourceId:           resourceId,
+		PolicyAssignmentName: name,
+	}, nil
+}
+
+// validateResourceScopeID validates that the input is the ID of a Resource, rather than a Subscription or a Resource Group
+func validateResourceScopeID(input string) error {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return err
+	}
+
+	if id.ResourceGroup == "" || id.Provider == "" || len(id.Path) == 0 {
+		return fmt.Errorf("expected %q to be the ID of a Resource", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SubscriptionPolicyAssignmentId struct {
+	SubscriptionId       string
+	PolicyAssignmentName string
+}
+
+func NewSubscriptionPolicyAssignmentID(subscriptionId, policyAssignmentName string) SubscriptionPolicyAssignmentId {
+	return SubscriptionPolicyAssignmentId{
+		SubscriptionId:       subscriptionId,
+		PolicyAssignmentName: policyAssignmentName,
+	}
+}
+
+func (id SubscriptionPolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Policy Assignment Name %q", id.PolicyAssignmentName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Subscription Policy Assignment", segmentsStr)
+}
+
+func (id SubscriptionPolicyAssignmentId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Authorization/policyAssignments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.PolicyAssignmentName)
+}
+
+// SubscriptionPolicyAssignmentID parses a SubscriptionPolicyAssignment ID into an SubscriptionPolicyAssignmentId struct
+func SubscriptionPolicyAssignmentID(input string) (*SubscriptionPolicyAssignmentId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SubscriptionPolicyAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.PolicyAssignmentName, err = id.PopSegment("policyAssignments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,540 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-09-01/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	policyAssignmentIdentityTypeSystemAssigned = "SystemAssigned"
+	policyAssignmentIdentityTypeUserAssigned   = "UserAssigned"
+)
+
+// policyAssignmentScopeDefinition describes a single kind of Scope for a Policy Assignment, from which a
+// Resource is built by resourceArmPolicyAssignmentAtScope - since the API is the same for every kind of
+// Scope and only the format of the Resource ID differs
+type policyAssignmentScopeDefinition struct {
+	// ResourceType is the name of the Terraform Resource, e.g. `azurerm_resource_group_policy_assignment`
+	ResourceType string
+
+	// ScopeField is the name of the field containing the ID of the Scope, e.g. `resource_group_id`
+	ScopeField string
+
+	// ScopeValidateFunc validates the ID of the Scope
+	ScopeValidateFunc schema.SchemaValidateFunc
+
+	// BuildID returns the ID of the Policy Assignment with the specified name at the Scope
+	BuildID func(scopeId string, name string) (string, error)
+
+	// ParseID parses the ID of the Policy Assignment, returning the ID of the Scope and the name
+	ParseID func(input string) (*policyAssignmentAtScopeId, error)
+}
+
+type policyAssignmentAtScopeId struct {
+	ScopeId string
+	Name    string
+}
+
+func resourceArmPolicyAssignmentAtScope(definition policyAssignmentScopeDefinition) *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPolicyAssignmentAtScopeCreateUpdate(definition),
+		Read:   resourceArmPolicyAssignmentAtScopeRead(definition),
+		Update: resourceArmPolicyAssignmentAtScopeCreateUpdate(definition),
+		Delete: resourceArmPolicyAssignmentAtScopeDelete(definition),
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := definition.ParseID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			definition.ScopeField: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: definition.ScopeValidateFunc,
+			},
+
+			"policy_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validate.PolicyDefinitionID,
+					validate.PolicySetDefinitionID,
+				),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enforcement_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								policyAssignmentIdentityTypeSystemAssigned,
+								policyAssignmentIdentityTypeUserAssigned,
+							}, false),
+						},
+
+						// the API only supports a single User Assigned Identity for a Policy Assignment
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// whilst a Location is only required when an Identity is assigned, this can't be changed once set
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"metadata": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: policyAssignmentsMetadataDiffSuppressFunc,
+			},
+
+			"non_compliance_message": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"policy_definition_reference_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"not_scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			identities := d.Get("identity").([]interface{})
+			if len(identities) == 0 || identities[0] == nil {
+				return nil
+			}
+
+			if d.NewValueKnown("location") && d.Get("location").(string) == "" {
+				return fmt.Errorf("`location` must be set when `identity` is assigned")
+			}
+
+			identity := identities[0].(map[string]interface{})
+			identityIds := identity["identity_ids"].([]interface{})
+			switch identity["type"].(string) {
+			case policyAssignmentIdentityTypeSystemAssigned:
+				if len(identityIds) > 0 {
+					return fmt.Errorf("`identity_ids` can only be specified when `type` is `%s`", policyAssignmentIdentityTypeUserAssigned)
+				}
+			case policyAssignmentIdentityTypeUserAssigned:
+				if len(identityIds) == 0 && d.NewValueKnown("identity.0.identity_ids") {
+					return fmt.Errorf("`identity_ids` must be specified when `type` is `%s`", policyAssignmentIdentityTypeUserAssigned)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmPolicyAssignmentAtScopeCreateUpdate(definition policyAssignmentScopeDefinition) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := azuresdkhacks.NewAssignmentsWorkaroundClient(meta.(*clients.Client).Policy.AssignmentsClient)
+		ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		resourceId, err := definition.BuildID(d.Get(definition.ScopeField).(string), d.Get("name").(string))
+		if err != nil {
+			return err
+		}
+		id, err := definition.ParseID(resourceId)
+		if err != nil {
+			return err
+		}
+
+		if d.IsNewResource() {
+			existing, err := client.Get(ctx, id.ScopeId, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing Policy Assignment %q (Scope %q): %+v", id.Name, id.ScopeId, err)
+				}
+			}
+
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return tf.ImportAsExistsError(definition.ResourceType, resourceId)
+			}
+		}
+
+		assignment := azuresdkhacks.Assignment{
+			Properties: &azuresdkhacks.AssignmentProperties{
+				PolicyDefinitionID:    utils.String(d.Get("policy_definition_id").(string)),
+				DisplayName:           utils.String(d.Get("display_name").(string)),
+				Scope:                 utils.String(id.ScopeId),
+				EnforcementMode:       convertEnforcementMode(d.Get("enforcement_mode").(bool)),
+				NotScopes:             expandAzureRmPolicyNotScopes(d.Get("not_scopes").([]interface{})),
+				NonComplianceMessages: expandPolicyAssignmentNonComplianceMessages(d.Get("non_compliance_message").([]interface{})),
+			},
+			Identity: expandPolicyAssignmentAtScopeIdentity(d.Get("identity").([]interface{})),
+		}
+
+		if v := d.Get("description").(string); v != "" {
+			assignment.Properties.Description = utils.String(v)
+		}
+
+		if v := d.Get("location").(string); v != "" {
+			assignment.Location = utils.String(location.Normalize(v))
+		}
+
+		if v := d.Get("parameters").(string); v != "" {
+			expandedParams, err := expandParameterValuesValueFromString(v)
+			if err != nil {
+				return fmt.Errorf("expanding JSON for `parameters` %q: %+v", v, err)
+			}
+
+			assignment.Properties.Parameters = expandedParams
+		}
+
+		if metaDataString := d.Get("metadata").(string); metaDataString != "" {
+			metaData, err := structure.ExpandJsonFromString(metaDataString)
+			if err != nil {
+				return fmt.Errorf("unable to parse metadata: %s", err)
+			}
+			assignment.Properties.Metadata = &metaData
+		}
+
+		if _, err := client.Create(ctx, id.ScopeId, id.Name, assignment); err != nil {
+			return fmt.Errorf("creating/updating Policy Assignment %q (Scope %q): %+v", id.Name, id.ScopeId, err)
+		}
+
+		// Policy Assignments are eventually consistent; wait for them to stabilize
+		log.Printf("[DEBUG] Waiting for Policy Assignment %q (Scope %q) to become available", id.Name, id.ScopeId)
+		stateConf := &resource.StateChangeConf{
+			Pending:                   []string{"404"},
+			Target:                    []string{"200"},
+			Refresh:                   policyAssignmentAtScopeRefreshFunc(ctx, client, *id),
+			MinTimeout:                10 * time.Second,
+			ContinuousTargetOccurence: 10,
+		}
+
+		if d.IsNewResource() {
+			stateConf.Timeout = d.Timeout(schema.TimeoutCreate)
+		} else {
+			stateConf.Timeout = d.Timeout(schema.TimeoutUpdate)
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("waiting for Policy Assignment %q (Scope %q) to become available: %+v", id.Name, id.ScopeId, err)
+		}
+
+		d.SetId(resourceId)
+
+		return resourceArmPolicyAssignmentAtScopeRead(definition)(d, meta)
+	}
+}
+
+func resourceArmPolicyAssignmentAtScopeRead(definition policyAssignmentScopeDefinition) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := azuresdkhacks.NewAssignmentsWorkaroundClient(meta.(*clients.Client).Policy.AssignmentsClient)
+		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := definition.ParseID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ScopeId, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Policy Assignment %q (Scope %q) was not found - removing from state", id.Name, id.ScopeId)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Policy Assignment %q (Scope %q): %+v", id.Name, id.ScopeId, err)
+		}
+
+		// the Scope and Name are taken from the Resource ID, rather than the API response, since the casing
+		// of the ID's returned by the API differs depending on the kind of Scope
+		d.Set("name", id.Name)
+		d.Set(definition.ScopeField, id.ScopeId)
+
+		identity, err := flattenPolicyAssignmentAtScopeIdentity(resp.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", identity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if resp.Location != nil {
+			d.Set("location", location.Normalize(*resp.Location))
+		}
+
+		if props := resp.Properties; props != nil {
+			d.Set("policy_definition_id", props.PolicyDefinitionID)
+			d.Set("description", props.Description)
+			d.Set("display_name", props.DisplayName)
+			d.Set("enforcement_mode", props.EnforcementMode == policy.Default)
+
+			if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
+				d.Set("metadata", metadataStr)
+			}
+
+			parameters := ""
+			if params := props.Parameters; params != nil {
+				json, err := flattenParameterValuesValueToString(params)
+				if err != nil {
+					return fmt.Errorf("serializing JSON from `parameters`: %+v", err)
+				}
+				parameters = json
+			}
+			d.Set("parameters", parameters)
+
+			if err := d.Set("non_compliance_message", flattenPolicyAssignmentNonComplianceMessages(props.NonComplianceMessages)); err != nil {
+				return fmt.Errorf("setting `non_compliance_message`: %+v", err)
+			}
+
+			if err := d.Set("not_scopes", utils.FlattenStringSlice(props.NotScopes)); err != nil {
+				return fmt.Errorf("setting `not_scopes`: %+v", err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceArmPolicyAssignmentAtScopeDelete(definition policyAssignmentScopeDefinition) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := azuresdkhacks.NewAssignmentsWorkaroundClient(meta.(*clients.Client).Policy.AssignmentsClient)
+		ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := definition.ParseID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.Delete(ctx, id.ScopeId, id.Name); err != nil {
+			return fmt.Errorf("deleting Policy Assignment %q (Scope %q): %+v", id.Name, id.ScopeId, err)
+		}
+
+		return nil
+	}
+}
+
+func policyAssignmentAtScopeRefreshFunc(ctx context.Context, client azuresdkhacks.AssignmentsWorkaroundClient, id policyAssignmentAtScopeId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id.ScopeId, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(res.Response) {
+				return res, strconv.Itoa(res.StatusCode), nil
+			}
+			return nil, "", fmt.Errorf("issuing read request in policyAssignmentAtScopeRefreshFunc for Policy Assignment %q (Scope %q): %+v", id.Name, id.ScopeId, err)
+		}
+
+		return res, strconv.Itoa(res.StatusCode), nil
+	}
+}
+
+func expandPolicyAssignmentAtScopeIdentity(input []interface{}) *azuresdkhacks.AssignmentIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.AssignmentIdentity{
+			Type: string(policy.None),
+		}
+	}
+	raw := input[0].(map[string]interface{})
+
+	identity := azuresdkhacks.AssignmentIdentity{
+		Type: raw["type"].(string),
+	}
+
+	if identityIds := raw["identity_ids"].([]interface{}); len(identityIds) > 0 {
+		identity.UserAssignedIdentities = make(map[string]*azuresdkhacks.AssignmentUserAssignedIdentity)
+		for _, v := range identityIds {
+			identity.UserAssignedIdentities[v.(string)] = &azuresdkhacks.AssignmentUserAssignedIdentity{}
+		}
+	}
+
+	return &identity
+}
+
+func flattenPolicyAssignmentAtScopeIdentity(input *azuresdkhacks.AssignmentIdentity) ([]interface{}, error) {
+	if input == nil || input.Type == "" || input.Type == string(policy.None) {
+		return []interface{}{}, nil
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		// the casing of the keys returned by the API differs from the ID's which were sent
+		parsedId, err := msiParse.UserAssignedIdentityID(key)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = append(identityIds, parsedId.ID())
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         input.Type,
+			"identity_ids": identityIds,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}, nil
+}
+
+func expandPolicyAssignmentNonComplianceMessages(input []interface{}) *[]azuresdkhacks.NonComplianceMessage {
+	results := make([]azuresdkhacks.NonComplianceMessage, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		message := azuresdkhacks.NonComplianceMessage{
+			Message: utils.String(v["content"].(string)),
+		}
+		if referenceId := v["policy_definition_reference_id"].(string); referenceId != "" {
+			message.PolicyDefinitionReferenceID = utils.String(referenceId)
+		}
+
+		results = append(results, message)
+	}
+
+	return &results
+}
+
+func flattenPolicyAssignmentNonComplianceMessages(input *[]azuresdkhacks.NonComplianceMessage) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		content := ""
+		if item.Message != nil {
+			content = *item.Message
+		}
+
+		referenceId := ""
+		if item.PolicyDefinitionReferenceID != nil {
+			referenceId = *item.PolicyDefinitionReferenceID
+		}
+
+		results = append(results, map[string]interface{}{
+			"content":                        content,
+			"policy_definition_reference_id": referenceId,
+		})
+	}
+
+	return results
+}
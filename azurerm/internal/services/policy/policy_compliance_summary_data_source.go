@@ -0,0 +1,310 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmPolicyComplianceSummary() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPolicyComplianceSummaryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.PolicyScopeID,
+			},
+
+			"policy_assignment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.PolicyAssignmentID,
+			},
+
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"non_compliant_resources": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"non_compliant_policies": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"resource_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"policy_assignments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_assignment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"policy_set_definition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"non_compliant_resources": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"non_compliant_policies": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"non_compliant_resource_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmPolicyComplianceSummaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.PolicyStatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope, err := parse.PolicyScopeID(d.Get("scope").(string))
+	if err != nil {
+		return err
+	}
+
+	filters := make([]string, 0)
+	if v := d.Get("policy_assignment_id").(string); v != "" {
+		filters = append(filters, fmt.Sprintf("PolicyAssignmentId eq '%s'", v))
+	}
+	if v := d.Get("resource_type").(string); v != "" {
+		filters = append(filters, fmt.Sprintf("ResourceType eq '%s'", v))
+	}
+	filter := strings.Join(filters, " and ")
+
+	summary, err := policyStatesSummarizeAtScope(ctx, client, scope, filter)
+	if err != nil {
+		return fmt.Errorf("summarizing the Policy States (Scope %q): %+v", scope.ScopeId(), err)
+	}
+
+	nonCompliantFilter := "ComplianceState eq 'NonCompliant'"
+	if filter != "" {
+		nonCompliantFilter = fmt.Sprintf("%s and %s", filter, nonCompliantFilter)
+	}
+	nonCompliantResourceIds, err := policyStatesListResourceIdsAtScope(ctx, client, scope, nonCompliantFilter)
+	if err != nil {
+		return fmt.Errorf("listing the non-compliant Policy States (Scope %q): %+v", scope.ScopeId(), err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	nonCompliantResources := 0
+	nonCompliantPolicies := 0
+	resourceDetails := make([]interface{}, 0)
+	policyAssignments := make([]interface{}, 0)
+	if summary.Value != nil && len(*summary.Value) > 0 {
+		// the API always returns a single summary for the requested scope
+		item := (*summary.Value)[0]
+		if results := item.Results; results != nil {
+			nonCompliantResources, nonCompliantPolicies = flattenPolicySummaryResultCounts(results)
+			resourceDetails = flattenPolicyComplianceDetails(results.ResourceDetails)
+		}
+		policyAssignments = flattenPolicyAssignmentSummaries(item.PolicyAssignments)
+	}
+
+	d.Set("non_compliant_resources", nonCompliantResources)
+	d.Set("non_compliant_policies", nonCompliantPolicies)
+
+	if err := d.Set("resource_details", resourceDetails); err != nil {
+		return fmt.Errorf("setting `resource_details`: %+v", err)
+	}
+
+	if err := d.Set("policy_assignments", policyAssignments); err != nil {
+		return fmt.Errorf("setting `policy_assignments`: %+v", err)
+	}
+
+	if err := d.Set("non_compliant_resource_ids", nonCompliantResourceIds); err != nil {
+		return fmt.Errorf("setting `non_compliant_resource_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+// policyStatesSummarizeAtScope is a wrapper of the 4 Summarize functions on PolicyStatesClient, combining them into one to simplify code.
+func policyStatesSummarizeAtScope(ctx context.Context, client *policyinsights.PolicyStatesClient, scopeId parse.PolicyScopeId, filter string) (policyinsights.SummarizeResults, error) {
+	switch scopeId := scopeId.(type) {
+	case parse.ScopeAtSubscription:
+		return client.SummarizeForSubscription(ctx, scopeId.SubscriptionId, nil, nil, nil, filter)
+	case parse.ScopeAtResourceGroup:
+		return client.SummarizeForResourceGroup(ctx, scopeId.SubscriptionId, scopeId.ResourceGroup, nil, nil, nil, filter)
+	case parse.ScopeAtResource:
+		return client.SummarizeForResource(ctx, scopeId.ScopeId(), nil, nil, nil, filter)
+	case parse.ScopeAtManagementGroup:
+		return client.SummarizeForManagementGroup(ctx, scopeId.ManagementGroupName, nil, nil, nil, filter)
+	default:
+		return policyinsights.SummarizeResults{}, fmt.Errorf("invalid scope type")
+	}
+}
+
+// policyStatesListResourceIdsAtScope returns the distinct IDs of the resources which have a latest Policy State matching the filter.
+func policyStatesListResourceIdsAtScope(ctx context.Context, client *policyinsights.PolicyStatesClient, scopeId parse.PolicyScopeId, filter string) ([]string, error) {
+	var iterator policyinsights.PolicyStatesQueryResultsIterator
+	var err error
+
+	selectParameter := "ResourceId"
+	switch scopeId := scopeId.(type) {
+	case parse.ScopeAtSubscription:
+		iterator, err = client.ListQueryResultsForSubscriptionComplete(ctx, policyinsights.Latest, scopeId.SubscriptionId, nil, "", selectParameter, nil, nil, filter, "", "")
+	case parse.ScopeAtResourceGroup:
+		iterator, err = client.ListQueryResultsForResourceGroupComplete(ctx, policyinsights.Latest, scopeId.SubscriptionId, scopeId.ResourceGroup, nil, "", selectParameter, nil, nil, filter, "", "")
+	case parse.ScopeAtResource:
+		iterator, err = client.ListQueryResultsForResourceComplete(ctx, policyinsights.Latest, scopeId.ScopeId(), nil, "", selectParameter, nil, nil, filter, "", "", "")
+	case parse.ScopeAtManagementGroup:
+		iterator, err = client.ListQueryResultsForManagementGroupComplete(ctx, policyinsights.Latest, scopeId.ManagementGroupName, nil, "", selectParameter, nil, nil, filter, "", "")
+	default:
+		return nil, fmt.Errorf("invalid scope type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// a resource has a Policy State for each Policy Definition it's evaluated against, so these need to be de-duplicated
+	resourceIds := make(map[string]struct{})
+	for iterator.NotDone() {
+		if v := iterator.Value().ResourceID; v != nil && *v != "" {
+			resourceIds[strings.ToLower(*v)] = struct{}{}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]string, 0)
+	for id := range resourceIds {
+		results = append(results, id)
+	}
+	sort.Strings(results)
+
+	return results, nil
+}
+
+func flattenPolicySummaryResultCounts(input *policyinsights.SummaryResults) (int, int) {
+	nonCompliantResources := 0
+	nonCompliantPolicies := 0
+	if input == nil {
+		return nonCompliantResources, nonCompliantPolicies
+	}
+
+	if input.NonCompliantResources != nil {
+		nonCompliantResources = int(*input.NonCompliantResources)
+	}
+	if input.NonCompliantPolicies != nil {
+		nonCompliantPolicies = int(*input.NonCompliantPolicies)
+	}
+
+	return nonCompliantResources, nonCompliantPolicies
+}
+
+func flattenPolicyComplianceDetails(input *[]policyinsights.ComplianceDetail) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		complianceState := ""
+		if item.ComplianceState != nil {
+			complianceState = *item.ComplianceState
+		}
+
+		count := 0
+		if item.Count != nil {
+			count = int(*item.Count)
+		}
+
+		results = append(results, map[string]interface{}{
+			"compliance_state": complianceState,
+			"count":            count,
+		})
+	}
+
+	return results
+}
+
+func flattenPolicyAssignmentSummaries(input *[]policyinsights.PolicyAssignmentSummary) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		policyAssignmentId := ""
+		if item.PolicyAssignmentID != nil {
+			policyAssignmentId = *item.PolicyAssignmentID
+		}
+
+		policySetDefinitionId := ""
+		if item.PolicySetDefinitionID != nil {
+			policySetDefinitionId = *item.PolicySetDefinitionID
+		}
+
+		nonCompliantResources, nonCompliantPolicies := flattenPolicySummaryResultCounts(item.Results)
+
+		results = append(results, map[string]interface{}{
+			"policy_assignment_id":     policyAssignmentId,
+			"policy_set_definition_id": policySetDefinitionId,
+			"non_compliant_resources":  nonCompliantResources,
+			"non_compliant_policies":   nonCompliantPolicies,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,127 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type PolicyComplianceSummaryDataSource struct{}
+
+func TestAccDataSourceAzureRMPolicyComplianceSummary_atResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_summary", "test")
+	d := PolicyComplianceSummaryDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.atResourceGroup(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("non_compliant_resources").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_policies").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_resource_ids.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMPolicyComplianceSummary_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_summary", "test")
+	d := PolicyComplianceSummaryDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.filtered(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("non_compliant_resources").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_resource_ids.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d PolicyComplianceSummaryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]s"
+  location = "%[2]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestDef-%[1]s"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestDef-%[1]s"
+
+  policy_rule = <<POLICY_RULE
+    {
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+    {
+    "allowedLocations": {
+      "type": "Array",
+      "metadata": {
+        "description": "The list of allowed locations for resources.",
+        "displayName": "Allowed locations",
+        "strongType": "location"
+      }
+    }
+  }
+PARAMETERS
+}
+
+resource "azurerm_policy_assignment" "test" {
+  name                 = "acctestAssign-%[1]s"
+  scope                = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  display_name         = "acctestAssign-%[1]s"
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "West Europe" ]
+  }
+}
+PARAMETERS
+}
+`, data.RandomString, data.Locations.Primary)
+}
+
+func (d PolicyComplianceSummaryDataSource) atResourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_compliance_summary" "test" {
+  scope = azurerm_policy_assignment.test.scope
+}
+`, d.template(data))
+}
+
+func (d PolicyComplianceSummaryDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_compliance_summary" "test" {
+  scope                = azurerm_policy_assignment.test.scope
+  policy_assignment_id = azurerm_policy_assignment.test.id
+  resource_type        = "Microsoft.Resources/resourceGroups"
+}
+`, d.template(data))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
@@ -89,6 +90,14 @@ func resourceArmPolicyRemediation() *schema.Resource {
 					string(policyinsights.ReEvaluateCompliance),
 				}, false),
 			},
+
+			// the remediation itself isn't a long-running operation, instead this allows for waiting for the
+			// deployments required by the remediation to finish - and surfacing any that fail
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -153,6 +162,33 @@ func resourceArmPolicyRemediationCreateUpdate(d *schema.ResourceData, meta inter
 	}
 	d.SetId(*resp.ID)
 
+	if d.Get("wait_for_completion").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		log.Printf("[DEBUG] waiting for the Policy Remediation %q (Scope %q) to complete", name, scope.ScopeId())
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"Accepted", "Evaluating", "Running"},
+			Target: []string{
+				"Succeeded", "Complete", "Canceled", "Failed",
+			},
+			Refresh:    policyRemediationProvisioningStateRefreshFunc(ctx, client, name, scope),
+			MinTimeout: 30 * time.Second,
+			Timeout:    timeout,
+		}
+
+		result, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("waiting for Policy Remediation %q (Scope %q) to complete: %+v", name, scope.ScopeId(), err)
+		}
+
+		if err := checkPolicyRemediationDeployments(result.(policyinsights.Remediation)); err != nil {
+			return fmt.Errorf("Policy Remediation %q (Scope %q) %+v", name, scope.ScopeId(), err)
+		}
+	}
+
 	return resourceArmPolicyRemediationRead(d, meta)
 }
 
@@ -228,7 +264,7 @@ func resourceArmPolicyRemediationDelete(d *schema.ResourceData, meta interface{}
 			Target: []string{
 				"Succeeded", "Canceled", "Failed",
 			},
-			Refresh:    policyRemediationCancellationRefreshFunc(ctx, client, id.Name, id.PolicyScopeId),
+			Refresh:    policyRemediationProvisioningStateRefreshFunc(ctx, client, id.Name, id.PolicyScopeId),
 			MinTimeout: 10 * time.Second,
 			Timeout:    d.Timeout(schema.TimeoutDelete),
 		}
@@ -276,11 +312,11 @@ func cancelRemediation(ctx context.Context, client *policyinsights.RemediationsC
 	}
 }
 
-func policyRemediationCancellationRefreshFunc(ctx context.Context, client *policyinsights.RemediationsClient, name string, scopeId parse.PolicyScopeId) resource.StateRefreshFunc {
+func policyRemediationProvisioningStateRefreshFunc(ctx context.Context, client *policyinsights.RemediationsClient, name string, scopeId parse.PolicyScopeId) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := RemediationGetAtScope(ctx, client, name, scopeId)
 		if err != nil {
-			return nil, "", fmt.Errorf("issuing read request in policyRemediationCancellationRefreshFunc for Policy Remediation %q (Scope %q): %+v", name, scopeId.ScopeId(), err)
+			return nil, "", fmt.Errorf("issuing read request in policyRemediationProvisioningStateRefreshFunc for Policy Remediation %q (Scope %q): %+v", name, scopeId.ScopeId(), err)
 		}
 
 		if resp.RemediationProperties == nil {
@@ -293,6 +329,39 @@ func policyRemediationCancellationRefreshFunc(ctx context.Context, client *polic
 	}
 }
 
+// checkPolicyRemediationDeployments returns an error when a completed remediation was unsuccessful or any of its deployments failed
+func checkPolicyRemediationDeployments(remediation policyinsights.Remediation) error {
+	props := remediation.RemediationProperties
+	if props == nil {
+		return fmt.Errorf("`properties` was nil")
+	}
+
+	state := ""
+	if props.ProvisioningState != nil {
+		state = *props.ProvisioningState
+	}
+
+	failed := int32(0)
+	total := int32(0)
+	if status := props.DeploymentStatus; status != nil {
+		if status.FailedDeployments != nil {
+			failed = *status.FailedDeployments
+		}
+		if status.TotalDeployments != nil {
+			total = *status.TotalDeployments
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("completed with %d of %d deployments failed (Provisioning State %q)", failed, total, state)
+	}
+	if strings.EqualFold(state, "Failed") || strings.EqualFold(state, "Canceled") {
+		return fmt.Errorf("completed with the Provisioning State %q", state)
+	}
+
+	return nil
+}
+
 // RemediationGetAtScope is a wrapper of the 4 Get functions on RemediationsClient, combining them into one to simplify code.
 func RemediationGetAtScope(ctx context.Context, client *policyinsights.RemediationsClient, name string, scopeId parse.PolicyScopeId) (policyinsights.Remediation, error) {
 	switch scopeId := scopeId.(type) {
@@ -28,7 +28,7 @@ func TestAccAzureRMPolicyRemediation_atSubscription(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -43,7 +43,7 @@ func TestAccAzureRMPolicyRemediation_atSubscriptionWithDefinitionSet(t *testing.
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -58,7 +58,7 @@ func TestAccAzureRMPolicyRemediation_atResourceGroup(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -73,7 +73,22 @@ func TestAccAzureRMPolicyRemediation_atResourceGroupWithDiscoveryMode(t *testing
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
+	})
+}
+
+func TestAccAzureRMPolicyRemediation_waitForCompletion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_remediation", "test")
+	r := PolicyRemediationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.waitForCompletion(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -88,7 +103,7 @@ func TestAccAzureRMPolicyRemediation_atManagementGroup(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -103,7 +118,7 @@ func TestAccAzureRMPolicyRemediation_atResource(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -118,14 +133,14 @@ func TestAccAzureRMPolicyRemediation_updateLocation(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 		{
 			Config: r.updateLocation(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("wait_for_completion"),
 	})
 }
 
@@ -466,6 +481,76 @@ resource "azurerm_policy_remediation" "test" {
 `, data.RandomString, data.Locations.Primary)
 }
 
+func (r PolicyRemediationResource) waitForCompletion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]s"
+  location = "%[2]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestDef-%[1]s"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "my-policy-definition"
+
+  policy_rule = <<POLICY_RULE
+    {
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+    {
+    "allowedLocations": {
+      "type": "Array",
+      "metadata": {
+        "description": "The list of allowed locations for resources.",
+        "displayName": "Allowed locations",
+        "strongType": "location"
+      }
+    }
+  }
+PARAMETERS
+}
+
+resource "azurerm_policy_assignment" "test" {
+  name                 = "acctestAssign-%[1]s"
+  scope                = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  description          = "Policy Assignment created via an Acceptance Test"
+  display_name         = "acctestAssign-%[1]s"
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "West Europe" ]
+  }
+}
+PARAMETERS
+}
+
+resource "azurerm_policy_remediation" "test" {
+  name                 = "acctestremediation-%[1]s"
+  scope                = azurerm_policy_assignment.test.scope
+  policy_assignment_id = azurerm_policy_assignment.test.id
+  wait_for_completion  = true
+}
+`, data.RandomString, data.Locations.Primary)
+}
+
 func (r PolicyRemediationResource) updateLocation(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -21,17 +21,22 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_policy_definition":     dataSourceArmPolicyDefinition(),
-		"azurerm_policy_set_definition": dataSourceArmPolicySetDefinition(),
+		"azurerm_policy_compliance_summary": dataSourceArmPolicyComplianceSummary(),
+		"azurerm_policy_definition":         dataSourceArmPolicyDefinition(),
+		"azurerm_policy_set_definition":     dataSourceArmPolicySetDefinition(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_policy_assignment":     resourceArmPolicyAssignment(),
-		"azurerm_policy_definition":     resourceArmPolicyDefinition(),
-		"azurerm_policy_set_definition": resourceArmPolicySetDefinition(),
-		"azurerm_policy_remediation":    resourceArmPolicyRemediation(),
+		"azurerm_management_group_policy_assignment": resourceArmManagementGroupPolicyAssignment(),
+		"azurerm_policy_assignment":                  resourceArmPolicyAssignment(),
+		"azurerm_policy_definition":                  resourceArmPolicyDefinition(),
+		"azurerm_policy_set_definition":              resourceArmPolicySetDefinition(),
+		"azurerm_policy_remediation":                 resourceArmPolicyRemediation(),
+		"azurerm_resource_group_policy_assignment":   resourceArmResourceGroupPolicyAssignment(),
+		"azurerm_resource_policy_assignment":         resourceArmResourcePolicyAssignment(),
+		"azurerm_subscription_policy_assignment":     resourceArmSubscriptionPolicyAssignment(),
 	}
 }
@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+	resourceParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	resourceValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+)
+
+func resourceArmResourceGroupPolicyAssignment() *schema.Resource {
+	return resourceArmPolicyAssignmentAtScope(policyAssignmentScopeDefinition{
+		ResourceType:      "azurerm_resource_group_policy_assignment",
+		ScopeField:        "resource_group_id",
+		ScopeValidateFunc: resourceValidate.ResourceGroupID,
+		BuildID: func(scopeId string, name string) (string, error) {
+			resourceGroupId, err := resourceParse.ResourceGroupID(scopeId)
+			if err != nil {
+				return "", err
+			}
+
+			return parse.NewResourceGroupPolicyAssignmentID(resourceGroupId.SubscriptionId, resourceGroupId.ResourceGroup, name).ID(), nil
+		},
+		ParseID: func(input string) (*policyAssignmentAtScopeId, error) {
+			id, err := parse.ResourceGroupPolicyAssignmentID(input)
+			if err != nil {
+				return nil, err
+			}
+
+			return &policyAssignmentAtScopeId{
+				ScopeId: resourceParse.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup).ID(),
+				Name:    id.PolicyAssignmentName,
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,280 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+	resourceParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ResourceGroupPolicyAssignmentResource struct{}
+
+func TestAccResourceGroupPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccResourceGroupPolicyAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupPolicyAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupPolicyAssignment_systemAssignedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.systemAssignedIdentity(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+				check.That(data.ResourceName).Key("identity.0.tenant_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ResourceGroupPolicyAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ResourceGroupPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentsClient := azuresdkhacks.NewAssignmentsWorkaroundClient(client.Policy.AssignmentsClient)
+	scope := resourceParse.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup).ID()
+	resp, err := assignmentsClient.Get(ctx, scope, id.PolicyAssignmentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r ResourceGroupPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "%[3]s" ]
+  }
+}
+PARAMETERS
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_policy_assignment" "import" {
+  name                 = azurerm_resource_group_policy_assignment.test.name
+  resource_group_id    = azurerm_resource_group_policy_assignment.test.resource_group_id
+  policy_definition_id = azurerm_resource_group_policy_assignment.test.policy_definition_id
+  parameters           = azurerm_resource_group_policy_assignment.test.parameters
+}
+`, r.basic(data))
+}
+
+func (r ResourceGroupPolicyAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  description          = "Policy Assignment created via an Acceptance Test"
+  display_name         = "Acceptance Test Run %[2]d"
+  enforcement_mode     = false
+  location             = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  non_compliance_message {
+    content = "Resources must be deployed to an allowed location"
+  }
+
+  metadata = <<METADATA
+{
+  "category": "Testing"
+}
+METADATA
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "%[3]s", "%[4]s" ]
+  }
+}
+PARAMETERS
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
+
+func (r ResourceGroupPolicyAssignmentResource) systemAssignedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  location             = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "%[3]s" ]
+  }
+}
+PARAMETERS
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%[1]d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%[1]d"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": "[parameters('allowedLocations')]"
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "type": "Array",
+    "metadata": {
+      "description": "The list of allowed locations for resources.",
+      "displayName": "Allowed locations",
+      "strongType": "location"
+    }
+  }
+}
+PARAMETERS
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+)
+
+func resourceArmResourcePolicyAssignment() *schema.Resource {
+	return resourceArmPolicyAssignmentAtScope(policyAssignmentScopeDefinition{
+		ResourceType:      "azurerm_resource_policy_assignment",
+		ScopeField:        "resource_id",
+		ScopeValidateFunc: azure.ValidateResourceID,
+		BuildID: func(scopeId string, name string) (string, error) {
+			// parsing the ID confirms that the Scope is a Resource, rather than a Subscription or Resource Group
+			id := parse.NewResourcePolicyAssignmentID(scopeId, name).ID()
+			if _, err := parse.ResourcePolicyAssignmentID(id); err != nil {
+				return "", err
+			}
+
+			return id, nil
+		},
+		ParseID: func(input string) (*policyAssignmentAtScopeId, error) {
+			id, err := parse.ResourcePolicyAssignmentID(input)
+			if err != nil {
+				return nil, err
+			}
+
+			return &policyAssignmentAtScopeId{
+				ScopeId: id.ResourceId,
+				Name:    id.PolicyAssignmentName,
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,4 @@
+package policy
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroupPolicyAssignment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SubscriptionPolicyAssignment -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1
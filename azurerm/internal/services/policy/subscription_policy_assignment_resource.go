@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+	subscriptionParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/parse"
+	subscriptionValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/validate"
+)
+
+func resourceArmSubscriptionPolicyAssignment() *schema.Resource {
+	return resourceArmPolicyAssignmentAtScope(policyAssignmentScopeDefinition{
+		ResourceType:      "azurerm_subscription_policy_assignment",
+		ScopeField:        "subscription_id",
+		ScopeValidateFunc: subscriptionValidate.SubscriptionID,
+		BuildID: func(scopeId string, name string) (string, error) {
+			subscriptionId, err := subscriptionParse.SubscriptionID(scopeId)
+			if err != nil {
+				return "", err
+			}
+
+			return parse.NewSubscriptionPolicyAssignmentID(subscriptionId.SubscriptionID, name).ID(), nil
+		},
+		ParseID: func(input string) (*policyAssignmentAtScopeId, error) {
+			id, err := parse.SubscriptionPolicyAssignmentID(input)
+			if err != nil {
+				return nil, err
+			}
+
+			return &policyAssignmentAtScopeId{
+				ScopeId: subscriptionParse.NewSubscriptionId(id.SubscriptionId).ID(),
+				Name:    id.PolicyAssignmentName,
+			}, nil
+		},
+	})
+}
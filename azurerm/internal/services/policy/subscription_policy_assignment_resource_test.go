@@ -0,0 +1,178 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
+	subscriptionParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SubscriptionPolicyAssignmentResource struct{}
+
+func TestAccSubscriptionPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
+	r := SubscriptionPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSubscriptionPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
+	r := SubscriptionPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSubscriptionPolicyAssignment_nonComplianceMessages(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
+	r := SubscriptionPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.nonComplianceMessages(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("non_compliance_message.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SubscriptionPolicyAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.SubscriptionPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentsClient := azuresdkhacks.NewAssignmentsWorkaroundClient(client.Policy.AssignmentsClient)
+	scope := subscriptionParse.NewSubscriptionId(id.SubscriptionId).ID()
+	resp, err := assignmentsClient.Get(ctx, scope, id.PolicyAssignmentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r SubscriptionPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  subscription_id      = data.azurerm_subscription.current.id
+  policy_definition_id = azurerm_policy_set_definition.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SubscriptionPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_assignment" "import" {
+  name                 = azurerm_subscription_policy_assignment.test.name
+  subscription_id      = azurerm_subscription_policy_assignment.test.subscription_id
+  policy_definition_id = azurerm_subscription_policy_assignment.test.policy_definition_id
+}
+`, r.basic(data))
+}
+
+func (r SubscriptionPolicyAssignmentResource) nonComplianceMessages(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  subscription_id      = data.azurerm_subscription.current.id
+  policy_definition_id = azurerm_policy_set_definition.test.id
+
+  non_compliance_message {
+    content = "This resource is not compliant with the Acceptance Test Policy Set"
+  }
+
+  non_compliance_message {
+    content                        = "Resources must be deployed to an allowed location"
+    policy_definition_reference_id = "allowedLocations"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SubscriptionPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%[1]d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%[1]d"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": ["%[2]s"]
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+}
+
+resource "azurerm_policy_set_definition" "test" {
+  name         = "acctestpolset-%[1]d"
+  policy_type  = "Custom"
+  display_name = "acctestpolset-%[1]d"
+
+  policy_definition_reference {
+    policy_definition_id = azurerm_policy_definition.test.id
+    reference_id         = "allowedLocations"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,192 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2019-06-01-preview/templatespecs"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Template Specs only exist in the vendored SDK as 2019-06-01-preview, which has no notion of a UI Form
+// Definition - a field which can't be bolted on, since the preview API rejects (and never returns) it. Rather
+// than having a Version written by one API version and read, patched or deleted by another, the Template Spec
+// Version resource performs every operation through this client against the 2021-05-01 GA API, borrowing only
+// the request building from the SDK.
+const templateSpecVersionsAPIVersion = "2021-05-01"
+
+type TemplateSpecVersionsWorkaroundClient struct {
+	sdkClient *templatespecs.VersionsClient
+}
+
+func NewTemplateSpecVersionsWorkaroundClient(client *templatespecs.VersionsClient) TemplateSpecVersionsWorkaroundClient {
+	return TemplateSpecVersionsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type TemplateSpecVersion struct {
+	autorest.Response `json:"-"`
+	ID                *string                        `json:"id,omitempty"`
+	Name              *string                        `json:"name,omitempty"`
+	Type              *string                        `json:"type,omitempty"`
+	Location          *string                        `json:"location,omitempty"`
+	Tags              map[string]*string             `json:"tags,omitempty"`
+	Properties        *TemplateSpecVersionProperties `json:"properties,omitempty"`
+}
+
+type TemplateSpecVersionProperties struct {
+	Description      *string                   `json:"description,omitempty"`
+	LinkedTemplates  *[]LinkedTemplateArtifact `json:"linkedTemplates,omitempty"`
+	MainTemplate     interface{}               `json:"mainTemplate,omitempty"`
+	UIFormDefinition interface{}               `json:"uiFormDefinition,omitempty"`
+}
+
+type LinkedTemplateArtifact struct {
+	Path     *string     `json:"path,omitempty"`
+	Template interface{} `json:"template,omitempty"`
+}
+
+// CreateOrUpdate creates or updates a Template Spec Version.
+func (client TemplateSpecVersionsWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, templateSpecName string, templateSpecVersion string, parameters TemplateSpecVersion) (result TemplateSpecVersion, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, templateSpecName, templateSpecVersion, templatespecs.VersionTemplatespecs{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, err
+	}
+
+	parameters.ID = nil
+	parameters.Name = nil
+	parameters.Type = nil
+	req, err = autorest.Prepare(req,
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": templateSpecVersionsAPIVersion,
+		}))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "CreateOrUpdate", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Get retrieves a Template Spec Version.
+func (client TemplateSpecVersionsWorkaroundClient) Get(ctx context.Context, resourceGroupName string, templateSpecName string, templateSpecVersion string) (result TemplateSpecVersion, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, templateSpecName, templateSpecVersion)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Update updates the Tags of a Template Spec Version.
+func (client TemplateSpecVersionsWorkaroundClient) Update(ctx context.Context, resourceGroupName string, templateSpecName string, templateSpecVersion string, parameters templatespecs.VersionUpdateModel) (result TemplateSpecVersion, err error) {
+	req, err := client.sdkClient.UpdatePreparer(ctx, resourceGroupName, templateSpecName, templateSpecVersion, &parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Update", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Update", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.UpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Update", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Update", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes a Template Spec Version.
+func (client TemplateSpecVersionsWorkaroundClient) Delete(ctx context.Context, resourceGroupName string, templateSpecName string, templateSpecVersion string) (result autorest.Response, err error) {
+	req, err := client.sdkClient.DeletePreparer(ctx, resourceGroupName, templateSpecName, templateSpecVersion)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Delete", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = client.withAPIVersion(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Delete", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.DeleteSender(req)
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Delete", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.sdkClient.DeleteResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "templatespecs.VersionsClient", "Delete", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (client TemplateSpecVersionsWorkaroundClient) withAPIVersion(req *http.Request) (*http.Request, error) {
+	return autorest.Prepare(req, autorest.WithQueryParameters(map[string]interface{}{
+		"api-version": templateSpecVersionsAPIVersion,
+	}))
+}
+
+func (client TemplateSpecVersionsWorkaroundClient) responder(resp *http.Response, codes ...int) (result TemplateSpecVersion, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(codes...),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -15,6 +15,7 @@ type Client struct {
 	ProvidersClient             *providers.ProvidersClient
 	ResourcesClient             *resources.Client
 	TagsClient                  *resources.TagsClient
+	TemplateSpecsClient         *templatespecs.Client
 	TemplateSpecsVersionsClient *templatespecs.VersionsClient
 }
 
@@ -35,6 +36,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
+	templatespecsClient := templatespecs.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&templatespecsClient.Client, o.ResourceManagerAuthorizer)
+
 	templatespecsVersionsClient := templatespecs.NewVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&templatespecsVersionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -44,6 +48,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
 		ResourcesClient:             &resourcesClient,
+		TemplateSpecsClient:         &templatespecsClient,
 		TemplateSpecsVersionsClient: &templatespecsVersionsClient,
 	}
 }
@@ -24,10 +24,11 @@ import (
 
 func managementGroupTemplateDeploymentResource() *schema.Resource {
 	return &schema.Resource{
-		Create: managementGroupTemplateDeploymentResourceCreate,
-		Read:   managementGroupTemplateDeploymentResourceRead,
-		Update: managementGroupTemplateDeploymentResourceUpdate,
-		Delete: managementGroupTemplateDeploymentResourceDelete,
+		Create:        managementGroupTemplateDeploymentResourceCreate,
+		Read:          managementGroupTemplateDeploymentResourceRead,
+		Update:        managementGroupTemplateDeploymentResourceUpdate,
+		Delete:        managementGroupTemplateDeploymentResourceDelete,
+		CustomizeDiff: managementGroupTemplateDeploymentResourceCustomizeDiff,
 		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.ManagementGroupTemplateDeploymentID(id)
 			return err
@@ -35,7 +36,7 @@ func managementGroupTemplateDeploymentResource() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Minute),
-			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(templateDeploymentReadTimeout),
 			Update: schema.DefaultTimeout(180 * time.Minute),
 			Delete: schema.DefaultTimeout(180 * time.Minute),
 		},
@@ -97,6 +98,8 @@ func managementGroupTemplateDeploymentResource() *schema.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:      schema.TypeString,
@@ -105,6 +108,8 @@ func managementGroupTemplateDeploymentResource() *schema.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -193,6 +198,11 @@ func managementGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, met
 		return err
 	}
 
+	// the `what_if` block only previews the Deployment during the plan, so a change to it alone mustn't re-run the Deployment
+	if !d.HasChanges("debug_level", "parameters_content", "tags", "template_content", "template_spec_version_id") {
+		return managementGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Management Group Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
 	if err != nil {
@@ -298,6 +308,8 @@ func managementGroupTemplateDeploymentResourceRead(d *schema.ResourceData, meta
 	}
 
 	d.Set("name", id.DeploymentName)
+	// the predicted changes only describe the plan they were computed for, so they're cleared once the Deployment exists
+	d.Set("what_if_changes", []interface{}{})
 	managementGroupId := mgParse.NewManagementGroupId(id.ManagementGroupName)
 	d.Set("management_group_id", managementGroupId.ID())
 	d.Set("location", location.NormalizeNilable(resp.Location))
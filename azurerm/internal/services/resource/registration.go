@@ -39,6 +39,8 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_resource_group_template_deployment":   resourceGroupTemplateDeploymentResource(),
 		"azurerm_subscription_template_deployment":     subscriptionTemplateDeploymentResource(),
 		"azurerm_template_deployment":                  resourceTemplateDeployment(),
+		"azurerm_template_spec":                        resourceTemplateSpec(),
+		"azurerm_template_spec_version":                resourceTemplateSpecVersion(),
 		"azurerm_tenant_template_deployment":           tenantTemplateDeploymentResource(),
 	}
 }
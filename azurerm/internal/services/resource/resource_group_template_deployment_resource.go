@@ -22,10 +22,11 @@ import (
 
 func resourceGroupTemplateDeploymentResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupTemplateDeploymentResourceCreate,
-		Read:   resourceGroupTemplateDeploymentResourceRead,
-		Update: resourceGroupTemplateDeploymentResourceUpdate,
-		Delete: resourceGroupTemplateDeploymentResourceDelete,
+		Create:        resourceGroupTemplateDeploymentResourceCreate,
+		Read:          resourceGroupTemplateDeploymentResourceRead,
+		Update:        resourceGroupTemplateDeploymentResourceUpdate,
+		Delete:        resourceGroupTemplateDeploymentResourceDelete,
+		CustomizeDiff: resourceGroupTemplateDeploymentResourceCustomizeDiff,
 		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.ResourceGroupTemplateDeploymentID(id)
 			return err
@@ -33,7 +34,7 @@ func resourceGroupTemplateDeploymentResource() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Minute),
-			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(templateDeploymentReadTimeout),
 			Update: schema.DefaultTimeout(180 * time.Minute),
 			Delete: schema.DefaultTimeout(180 * time.Minute),
 		},
@@ -97,6 +98,8 @@ func resourceGroupTemplateDeploymentResource() *schema.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:      schema.TypeString,
@@ -105,6 +108,8 @@ func resourceGroupTemplateDeploymentResource() *schema.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -188,6 +193,11 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
+	// the `what_if` block only previews the Deployment during the plan, so a change to it alone mustn't re-run the Deployment
+	if !d.HasChanges("debug_level", "deployment_mode", "parameters_content", "tags", "template_content", "template_spec_version_id") {
+		return resourceGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	template, err := client.Get(ctx, id.ResourceGroup, id.DeploymentName)
 	if err != nil {
@@ -294,6 +304,8 @@ func resourceGroupTemplateDeploymentResourceRead(d *schema.ResourceData, meta in
 	}
 
 	d.Set("name", id.DeploymentName)
+	// the predicted changes only describe the plan they were computed for, so they're cleared once the Deployment exists
+	d.Set("what_if_changes", []interface{}{})
 	d.Set("resource_group_name", id.ResourceGroup)
 
 	if props := resp.Properties; props != nil {
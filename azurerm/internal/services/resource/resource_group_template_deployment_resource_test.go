@@ -3,6 +3,7 @@ package resource_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -258,6 +259,66 @@ func TestAccResourceGroupTemplateDeployment_templateSpecResources(t *testing.T)
 	})
 }
 
+func TestAccResourceGroupTemplateDeployment_whatIf(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.whatIfConfig(data, "Incremental", false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("what_if"),
+		{
+			Config: r.whatIfConfig(data, "Incremental", true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the predicted changes are only exposed during the plan, so they're cleared once applied
+				check.That(data.ResourceName).Key("what_if_changes.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("what_if"),
+		{
+			// removing the Public IP in Complete mode would delete it, which isn't allowed
+			Config:      r.whatIfConfig(data, "Complete", false),
+			ExpectError: regexp.MustCompile("the What-If operation predicted changes which aren't allowed"),
+		},
+	})
+}
+
+func TestAccResourceGroupTemplateDeployment_whatIfToggleDoesNotRedeploy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	// the timestamp of the Deployment changes whenever it's (re-)run
+	var timestamp string
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.whatIfToggleConfig(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordDeploymentTimestamp(&timestamp)),
+			),
+		},
+		{
+			Config: r.whatIfToggleConfig(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.deploymentTimestampUnchanged(&timestamp)),
+			),
+		},
+		{
+			Config: r.whatIfToggleConfig(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.deploymentTimestampUnchanged(&timestamp)),
+			),
+		},
+	})
+}
+
 func (t ResourceGroupTemplateDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.ResourceGroupTemplateDeploymentID(state.ID)
 	if err != nil {
@@ -797,3 +858,130 @@ TEMPLATE
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (ResourceGroupTemplateDeploymentResource) whatIfConfig(data acceptance.TestData, deploymentMode string, withPublicIP bool) string {
+	resources := "[]"
+	if withPublicIP {
+		resources = fmt.Sprintf(`[
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      }
+    }
+  ]`, data.RandomInteger)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = %q
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": %s
+}
+TEMPLATE
+
+  what_if {
+    fail_on_change_types = ["Delete"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, deploymentMode, resources)
+}
+
+func (ResourceGroupTemplateDeploymentResource) deploymentTimestamp(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (string, error) {
+	id, err := parse.ResourceGroupTemplateDeploymentID(state.ID)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Resource.DeploymentsClient.Get(ctx, id.ResourceGroup, id.DeploymentName)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Properties == nil || resp.Properties.Timestamp == nil {
+		return "", fmt.Errorf("retrieving %s: `properties.timestamp` was nil", *id)
+	}
+
+	return resp.Properties.Timestamp.String(), nil
+}
+
+func (r ResourceGroupTemplateDeploymentResource) recordDeploymentTimestamp(timestamp *string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+		value, err := r.deploymentTimestamp(ctx, client, state)
+		if err != nil {
+			return err
+		}
+
+		*timestamp = value
+		return nil
+	}
+}
+
+func (r ResourceGroupTemplateDeploymentResource) deploymentTimestampUnchanged(timestamp *string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+		value, err := r.deploymentTimestamp(ctx, client, state)
+		if err != nil {
+			return err
+		}
+
+		if value != *timestamp {
+			return fmt.Errorf("expected the Deployment not to be re-run but the timestamp changed from %q to %q", *timestamp, value)
+		}
+		return nil
+	}
+}
+
+func (ResourceGroupTemplateDeploymentResource) whatIfToggleConfig(data acceptance.TestData, whatIf bool) string {
+	whatIfBlock := ""
+	if whatIf {
+		whatIfBlock = `
+  what_if {}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+%s}
+`, data.RandomInteger, data.Locations.Primary, whatIfBlock)
+}
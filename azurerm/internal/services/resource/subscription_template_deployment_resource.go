@@ -22,10 +22,11 @@ import (
 
 func subscriptionTemplateDeploymentResource() *schema.Resource {
 	return &schema.Resource{
-		Create: subscriptionTemplateDeploymentResourceCreate,
-		Read:   subscriptionTemplateDeploymentResourceRead,
-		Update: subscriptionTemplateDeploymentResourceUpdate,
-		Delete: subscriptionTemplateDeploymentResourceDelete,
+		Create:        subscriptionTemplateDeploymentResourceCreate,
+		Read:          subscriptionTemplateDeploymentResourceRead,
+		Update:        subscriptionTemplateDeploymentResourceUpdate,
+		Delete:        subscriptionTemplateDeploymentResourceDelete,
+		CustomizeDiff: subscriptionTemplateDeploymentResourceCustomizeDiff,
 		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.SubscriptionTemplateDeploymentID(id)
 			return err
@@ -33,7 +34,7 @@ func subscriptionTemplateDeploymentResource() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Minute),
-			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(templateDeploymentReadTimeout),
 			Update: schema.DefaultTimeout(180 * time.Minute),
 			Delete: schema.DefaultTimeout(180 * time.Minute),
 		},
@@ -88,6 +89,8 @@ func subscriptionTemplateDeploymentResource() *schema.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:      schema.TypeString,
@@ -96,6 +99,8 @@ func subscriptionTemplateDeploymentResource() *schema.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -180,6 +185,11 @@ func subscriptionTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta i
 		return err
 	}
 
+	// the `what_if` block only previews the Deployment during the plan, so a change to it alone mustn't re-run the Deployment
+	if !d.HasChanges("debug_level", "parameters_content", "tags", "template_content", "template_spec_version_id") {
+		return subscriptionTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Subscription Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
 	if err != nil {
@@ -283,6 +293,8 @@ func subscriptionTemplateDeploymentResourceRead(d *schema.ResourceData, meta int
 	}
 
 	d.Set("name", id.DeploymentName)
+	// the predicted changes only describe the plan they were computed for, so they're cleared once the Deployment exists
+	d.Set("what_if_changes", []interface{}{})
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
 	if props := resp.Properties; props != nil {
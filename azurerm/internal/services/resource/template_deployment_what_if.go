@@ -0,0 +1,329 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	mgParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// templateDeploymentReadTimeout is the default Read timeout for the Template Deployment resources, which is also used
+// for the What-If operation since it's run during the plan - where the configured timeouts aren't available
+const templateDeploymentReadTimeout = 5 * time.Minute
+
+type templateDeploymentWhatIfScope string
+
+const (
+	templateDeploymentWhatIfScopeResourceGroup   templateDeploymentWhatIfScope = "resourceGroup"
+	templateDeploymentWhatIfScopeSubscription    templateDeploymentWhatIfScope = "subscription"
+	templateDeploymentWhatIfScopeManagementGroup templateDeploymentWhatIfScope = "managementGroup"
+	templateDeploymentWhatIfScopeTenant          templateDeploymentWhatIfScope = "tenant"
+)
+
+// the Change Types which represent an actual change to a resource, `Ignore` and `NoChange` are omitted from `what_if_changes`
+var templateDeploymentWhatIfChangeTypes = []string{
+	string(resources.Create),
+	string(resources.Delete),
+	string(resources.Deploy),
+	string(resources.Modify),
+}
+
+func templateDeploymentWhatIfSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fail_on_change_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(templateDeploymentWhatIfChangeTypes, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateDeploymentWhatIfChangesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"change_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"resource_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupTemplateDeploymentResourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return templateDeploymentWhatIfCustomizeDiff(d, meta, templateDeploymentWhatIfScopeResourceGroup)
+}
+
+func subscriptionTemplateDeploymentResourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return templateDeploymentWhatIfCustomizeDiff(d, meta, templateDeploymentWhatIfScopeSubscription)
+}
+
+func managementGroupTemplateDeploymentResourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return templateDeploymentWhatIfCustomizeDiff(d, meta, templateDeploymentWhatIfScopeManagementGroup)
+}
+
+func tenantTemplateDeploymentResourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return templateDeploymentWhatIfCustomizeDiff(d, meta, templateDeploymentWhatIfScopeTenant)
+}
+
+// templateDeploymentWhatIfCustomizeDiff runs the What-If operation for the Template Deployment when it's opted into via
+// the `what_if` block and the Deployment is going to be (re-)deployed, exposing the predicted changes as `what_if_changes`
+func templateDeploymentWhatIfCustomizeDiff(d *schema.ResourceDiff, meta interface{}, scope templateDeploymentWhatIfScope) error {
+	whatIfRaw := d.Get("what_if").([]interface{})
+	if len(whatIfRaw) == 0 {
+		return nil
+	}
+
+	// the What-If operation is only meaningful when the Deployment is going to be run, or has just been opted into
+	fields := []string{"template_content", "template_spec_version_id", "parameters_content", "what_if"}
+	switch scope {
+	case templateDeploymentWhatIfScopeResourceGroup:
+		fields = append(fields, "deployment_mode", "resource_group_name")
+	case templateDeploymentWhatIfScopeManagementGroup:
+		fields = append(fields, "location", "management_group_id")
+	default:
+		fields = append(fields, "location")
+	}
+	if d.Id() != "" && !templateDeploymentWhatIfHasChange(d, fields) {
+		return nil
+	}
+
+	for _, field := range append(fields, "name") {
+		if !d.NewValueKnown(field) {
+			log.Printf("[DEBUG] Skipping the What-If operation since the value for %q isn't known until apply", field)
+			return d.SetNewComputed("what_if_changes")
+		}
+	}
+
+	properties, err := expandTemplateDeploymentWhatIfProperties(d, scope)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, templateDeploymentReadTimeout)
+	defer cancel()
+
+	deploymentName := d.Get("name").(string)
+	var result resources.WhatIfOperationResult
+	switch scope {
+	case templateDeploymentWhatIfScopeResourceGroup:
+		resourceGroup := d.Get("resource_group_name").(string)
+		future, err := client.WhatIf(ctx, resourceGroup, deploymentName, resources.DeploymentWhatIf{
+			Properties: properties,
+		})
+		if err != nil {
+			// the Resource Group may be created in the same apply, in which case the changes can't be predicted yet
+			if response.WasNotFound(future.Response()) {
+				log.Printf("[DEBUG] Skipping the What-If operation since Resource Group %q doesn't exist yet", resourceGroup)
+				return d.SetNewComputed("what_if_changes")
+			}
+			return fmt.Errorf("running What-If for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for What-If for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+		}
+		if result, err = future.Result(*client); err != nil {
+			return fmt.Errorf("retrieving What-If result for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+		}
+
+	case templateDeploymentWhatIfScopeSubscription:
+		future, err := client.WhatIfAtSubscriptionScope(ctx, deploymentName, resources.DeploymentWhatIf{
+			Location:   utils.String(location.Normalize(d.Get("location").(string))),
+			Properties: properties,
+		})
+		if err != nil {
+			return fmt.Errorf("running What-If for Subscription Template Deployment %q: %+v", deploymentName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for What-If for Subscription Template Deployment %q: %+v", deploymentName, err)
+		}
+		if result, err = future.Result(*client); err != nil {
+			return fmt.Errorf("retrieving What-If result for Subscription Template Deployment %q: %+v", deploymentName, err)
+		}
+
+	case templateDeploymentWhatIfScopeManagementGroup:
+		managementGroupId, err := mgParse.ManagementGroupID(d.Get("management_group_id").(string))
+		if err != nil {
+			return err
+		}
+
+		future, err := client.WhatIfAtManagementGroupScope(ctx, managementGroupId.Name, deploymentName, resources.ScopedDeploymentWhatIf{
+			Location:   utils.String(location.Normalize(d.Get("location").(string))),
+			Properties: properties,
+		})
+		if err != nil {
+			return fmt.Errorf("running What-If for Management Group Template Deployment %q: %+v", deploymentName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for What-If for Management Group Template Deployment %q: %+v", deploymentName, err)
+		}
+		if result, err = future.Result(*client); err != nil {
+			return fmt.Errorf("retrieving What-If result for Management Group Template Deployment %q: %+v", deploymentName, err)
+		}
+
+	case templateDeploymentWhatIfScopeTenant:
+		future, err := client.WhatIfAtTenantScope(ctx, deploymentName, resources.ScopedDeploymentWhatIf{
+			Location:   utils.String(location.Normalize(d.Get("location").(string))),
+			Properties: properties,
+		})
+		if err != nil {
+			return fmt.Errorf("running What-If for Tenant Template Deployment %q: %+v", deploymentName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for What-If for Tenant Template Deployment %q: %+v", deploymentName, err)
+		}
+		if result, err = future.Result(*client); err != nil {
+			return fmt.Errorf("retrieving What-If result for Tenant Template Deployment %q: %+v", deploymentName, err)
+		}
+	}
+
+	if result.Error != nil {
+		if result.Error.Message != nil {
+			return fmt.Errorf("running What-If for Template Deployment %q: %s", deploymentName, *result.Error.Message)
+		}
+		return fmt.Errorf("running What-If for Template Deployment %q: %+v", deploymentName, *result.Error)
+	}
+
+	changes := flattenTemplateDeploymentWhatIfChanges(result.WhatIfOperationProperties)
+
+	// an empty `what_if` block is valid, in which case the changes are only surfaced
+	if whatIf, ok := whatIfRaw[0].(map[string]interface{}); ok {
+		failOnChangeTypes := whatIf["fail_on_change_types"].(*schema.Set)
+		if err := checkTemplateDeploymentWhatIfChanges(changes, failOnChangeTypes.List()); err != nil {
+			return fmt.Errorf("Template Deployment %q: %+v", deploymentName, err)
+		}
+	}
+
+	return d.SetNew("what_if_changes", changes)
+}
+
+func templateDeploymentWhatIfHasChange(d *schema.ResourceDiff, fields []string) bool {
+	for _, field := range fields {
+		if d.HasChange(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandTemplateDeploymentWhatIfProperties(d *schema.ResourceDiff, scope templateDeploymentWhatIfScope) (*resources.DeploymentWhatIfProperties, error) {
+	properties := resources.DeploymentWhatIfProperties{
+		Mode: resources.Incremental,
+		WhatIfSettings: &resources.DeploymentWhatIfSettings{
+			ResultFormat: resources.ResourceIDOnly,
+		},
+	}
+
+	if scope == templateDeploymentWhatIfScopeResourceGroup {
+		properties.Mode = resources.DeploymentMode(d.Get("deployment_mode").(string))
+	}
+
+	if v := d.Get("template_spec_version_id").(string); v != "" {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(v),
+		}
+	} else if v := d.Get("template_content").(string); v != "" {
+		template, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if v := d.Get("parameters_content").(string); v != "" {
+		parameters, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	return &properties, nil
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return results
+	}
+
+	for _, change := range *input.Changes {
+		if !utils.SliceContainsValue(templateDeploymentWhatIfChangeTypes, string(change.ChangeType)) {
+			continue
+		}
+
+		resourceId := ""
+		if change.ResourceID != nil {
+			resourceId = *change.ResourceID
+		}
+
+		results = append(results, map[string]interface{}{
+			"change_type": string(change.ChangeType),
+			"resource_id": resourceId,
+		})
+	}
+
+	// the ordering of the changes isn't guaranteed by the API
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["resource_id"].(string) < results[j].(map[string]interface{})["resource_id"].(string)
+	})
+
+	return results
+}
+
+// checkTemplateDeploymentWhatIfChanges returns an error listing the predicted changes whose Change Type is one of `failOnChangeTypes`
+func checkTemplateDeploymentWhatIfChanges(changes []interface{}, failOnChangeTypes []interface{}) error {
+	if len(failOnChangeTypes) == 0 {
+		return nil
+	}
+
+	disallowed := make([]string, 0)
+	for _, raw := range changes {
+		change := raw.(map[string]interface{})
+		changeType := change["change_type"].(string)
+
+		for _, failOn := range failOnChangeTypes {
+			if strings.EqualFold(changeType, failOn.(string)) {
+				disallowed = append(disallowed, fmt.Sprintf("%s (%s)", change["resource_id"].(string), changeType))
+				break
+			}
+		}
+	}
+
+	if len(disallowed) > 0 {
+		return fmt.Errorf("the What-If operation predicted changes which aren't allowed by `fail_on_change_types`:\n\n%s", strings.Join(disallowed, "\n"))
+	}
+
+	return nil
+}
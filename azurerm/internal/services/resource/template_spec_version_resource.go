@@ -0,0 +1,337 @@
+package resource
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2019-06-01-preview/templatespecs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceTemplateSpecVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTemplateSpecVersionCreate,
+		Read:   resourceTemplateSpecVersionRead,
+		Update: resourceTemplateSpecVersionUpdate,
+		Delete: resourceTemplateSpecVersionDelete,
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.TemplateSpecVersionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		// NOTE: a published Version is treated as immutable, since a Deployment referencing it should always
+		// deploy the same thing - as such any change to the content of the Version requires a new Version
+
+		//lintignore:S033
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TemplateSpecVersionName,
+			},
+
+			"template_spec_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TemplateSpecID,
+			},
+
+			"template_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    utils.NormalizeJson,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+
+			"linked_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"template_content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsJSON,
+							StateFunc:    utils.NormalizeJson,
+						},
+					},
+				},
+			},
+
+			"ui_form_definition_content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    utils.NormalizeJson,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceTemplateSpecVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	templateSpecsClient := meta.(*clients.Client).Resource.TemplateSpecsClient
+	client := azuresdkhacks.NewTemplateSpecVersionsWorkaroundClient(meta.(*clients.Client).Resource.TemplateSpecsVersionsClient)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	templateSpecId, err := parse.TemplateSpecID(d.Get("template_spec_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewTemplateSpecVersionID(templateSpecId.SubscriptionId, templateSpecId.ResourceGroup, templateSpecId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	// since Versions are immutable we don't overwrite a Version which has already been published
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_template_spec_version", id.ID())
+	}
+
+	// the Version has to be in the same location as the Template Spec
+	templateSpec, err := templateSpecsClient.Get(ctx, templateSpecId.ResourceGroup, templateSpecId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *templateSpecId, err)
+	}
+	if templateSpec.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *templateSpecId)
+	}
+
+	template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `template_content`: %+v", err)
+	}
+
+	linkedTemplates, err := expandTemplateSpecVersionLinkedTemplates(d.Get("linked_template").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := azuresdkhacks.TemplateSpecVersion{
+		Location: templateSpec.Location,
+		Properties: &azuresdkhacks.TemplateSpecVersionProperties{
+			LinkedTemplates: linkedTemplates,
+			MainTemplate:    template,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ui_form_definition_content"); ok {
+		uiFormDefinition, err := expandTemplateDeploymentBody(v.(string))
+		if err != nil {
+			return fmt.Errorf("expanding `ui_form_definition_content`: %+v", err)
+		}
+		parameters.Properties.UIFormDefinition = uiFormDefinition
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceTemplateSpecVersionRead(d, meta)
+}
+
+func resourceTemplateSpecVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewTemplateSpecVersionsWorkaroundClient(meta.(*clients.Client).Resource.TemplateSpecsVersionsClient)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TemplateSpecVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the content of a Version is immutable, so only the tags can be updated in-place
+	if d.HasChange("tags") {
+		parameters := templatespecs.VersionUpdateModel{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceTemplateSpecVersionRead(d, meta)
+}
+
+func resourceTemplateSpecVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewTemplateSpecVersionsWorkaroundClient(meta.(*clients.Client).Resource.TemplateSpecsVersionsClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TemplateSpecVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VersionName)
+	d.Set("template_spec_id", parse.NewTemplateSpecID(id.SubscriptionId, id.ResourceGroup, id.TemplateSpecName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("description", props.Description)
+
+		templateContent, err := flattenTemplateDeploymentBody(props.MainTemplate)
+		if err != nil {
+			return fmt.Errorf("flattening `template_content`: %+v", err)
+		}
+		d.Set("template_content", templateContent)
+
+		linkedTemplates, err := flattenTemplateSpecVersionLinkedTemplates(props.LinkedTemplates)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("linked_template", linkedTemplates); err != nil {
+			return fmt.Errorf("setting `linked_template`: %+v", err)
+		}
+
+		uiFormDefinitionContent := ""
+		if props.UIFormDefinition != nil {
+			content, err := flattenTemplateDeploymentBody(props.UIFormDefinition)
+			if err != nil {
+				return fmt.Errorf("flattening `ui_form_definition_content`: %+v", err)
+			}
+			uiFormDefinitionContent = *content
+		}
+		d.Set("ui_form_definition_content", uiFormDefinitionContent)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceTemplateSpecVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewTemplateSpecVersionsWorkaroundClient(meta.(*clients.Client).Resource.TemplateSpecsVersionsClient)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TemplateSpecVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName); err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandTemplateSpecVersionLinkedTemplates(input []interface{}) (*[]azuresdkhacks.LinkedTemplateArtifact, error) {
+	results := make([]azuresdkhacks.LinkedTemplateArtifact, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		path := v["path"].(string)
+
+		template, err := expandTemplateDeploymentBody(v["template_content"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content` for the Linked Template %q: %+v", path, err)
+		}
+
+		results = append(results, azuresdkhacks.LinkedTemplateArtifact{
+			Path:     utils.String(path),
+			Template: template,
+		})
+	}
+
+	return &results, nil
+}
+
+func flattenTemplateSpecVersionLinkedTemplates(input *[]azuresdkhacks.LinkedTemplateArtifact) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		path := ""
+		if item.Path != nil {
+			path = *item.Path
+		}
+
+		templateContent, err := flattenTemplateDeploymentBody(item.Template)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `template_content` for the Linked Template %q: %+v", path, err)
+		}
+
+		results = append(results, map[string]interface{}{
+			"path":             path,
+			"template_content": *templateContent,
+		})
+	}
+
+	return results, nil
+}
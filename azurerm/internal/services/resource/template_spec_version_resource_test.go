@@ -0,0 +1,300 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type TemplateSpecVersionResource struct {
+}
+
+func TestAccTemplateSpecVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("location").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTemplateSpecVersion_linkedTemplates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.linkedTemplates(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_template.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_uiFormDefinition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.uiFormDefinition(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ui_form_definition_content").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_updateTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (TemplateSpecVersionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.TemplateSpecVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewTemplateSpecVersionsWorkaroundClient(clients.Resource.TemplateSpecsVersionsClient)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (TemplateSpecVersionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ts-%d"
+  location = %q
+}
+
+resource "azurerm_template_spec" "test" {
+  name                = "acctest-ts-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r TemplateSpecVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  description      = "Published by the Acceptance Tests"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "import" {
+  name             = azurerm_template_spec_version.test.name
+  template_spec_id = azurerm_template_spec_version.test.template_spec_id
+  template_content = azurerm_template_spec_version.test.template_content
+}
+`, r.basic(data))
+}
+
+func (r TemplateSpecVersionResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  description      = "Published by the Acceptance Tests"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) linkedTemplates(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Resources/deployments",
+      "apiVersion": "2020-06-01",
+      "name": "linked",
+      "properties": {
+        "mode": "Incremental",
+        "templateLink": {
+          "relativePath": "artifacts/empty.json"
+        }
+      }
+    }
+  ]
+}
+TEMPLATE
+
+  linked_template {
+    path = "artifacts/empty.json"
+
+    template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+  }
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) uiFormDefinition(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+
+  ui_form_definition_content = <<FORM
+{
+  "$schema": "https://schema.management.azure.com/schemas/2021-09-09/uiFormDefinition.schema.json",
+  "view": {
+    "kind": "Form",
+    "properties": {
+      "title": "Acceptance Test",
+      "steps": [
+        {
+          "name": "basics",
+          "label": "Basics",
+          "elements": [
+            {
+              "name": "resourceScope",
+              "type": "Microsoft.Common.ResourceScope"
+            }
+          ]
+        }
+      ]
+    },
+    "outputs": {
+      "parameters": {},
+      "kind": "ResourceGroup",
+      "location": "[steps('basics').resourceScope.location.name]",
+      "resourceGroupId": "[steps('basics').resourceScope.resourceGroup.id]"
+    }
+  }
+}
+FORM
+}
+`, r.template(data))
+}
@@ -22,10 +22,11 @@ import (
 
 func tenantTemplateDeploymentResource() *schema.Resource {
 	return &schema.Resource{
-		Create: tenantTemplateDeploymentResourceCreate,
-		Read:   tenantTemplateDeploymentResourceRead,
-		Update: tenantTemplateDeploymentResourceUpdate,
-		Delete: tenantTemplateDeploymentResourceDelete,
+		Create:        tenantTemplateDeploymentResourceCreate,
+		Read:          tenantTemplateDeploymentResourceRead,
+		Update:        tenantTemplateDeploymentResourceUpdate,
+		Delete:        tenantTemplateDeploymentResourceDelete,
+		CustomizeDiff: tenantTemplateDeploymentResourceCustomizeDiff,
 		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.TenantTemplateDeploymentID(id)
 			return err
@@ -33,7 +34,7 @@ func tenantTemplateDeploymentResource() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Minute),
-			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(templateDeploymentReadTimeout),
 			Update: schema.DefaultTimeout(180 * time.Minute),
 			Delete: schema.DefaultTimeout(180 * time.Minute),
 		},
@@ -88,6 +89,8 @@ func tenantTemplateDeploymentResource() *schema.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:      schema.TypeString,
@@ -96,6 +99,8 @@ func tenantTemplateDeploymentResource() *schema.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -179,6 +184,11 @@ func tenantTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	// the `what_if` block only previews the Deployment during the plan, so a change to it alone mustn't re-run the Deployment
+	if !d.HasChanges("debug_level", "parameters_content", "tags", "template_content", "template_spec_version_id") {
+		return tenantTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Tenant Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtTenantScope(ctx, id.DeploymentName)
 	if err != nil {
@@ -284,6 +294,8 @@ func tenantTemplateDeploymentResourceRead(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("name", id.DeploymentName)
+	// the predicted changes only describe the plan they were computed for, so they're cleared once the Deployment exists
+	d.Set("what_if_changes", []interface{}{})
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
 	if props := resp.Properties; props != nil {
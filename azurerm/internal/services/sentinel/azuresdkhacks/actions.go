@@ -0,0 +1,65 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ActionsWorkaroundClient retrieves Alert Rule Actions using the models below rather than those in the SDK,
+// since the SDK omits the `triggerUri` of an Action from the response
+type ActionsWorkaroundClient struct {
+	sdkClient *securityinsight.ActionsClient
+}
+
+func NewActionsWorkaroundClient(client *securityinsight.ActionsClient) ActionsWorkaroundClient {
+	return ActionsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type Action struct {
+	autorest.Response `json:"-"`
+	ID                *string           `json:"id,omitempty"`
+	Name              *string           `json:"name,omitempty"`
+	Etag              *string           `json:"etag,omitempty"`
+	Properties        *ActionProperties `json:"properties,omitempty"`
+}
+
+type ActionProperties struct {
+	WorkflowID         *string `json:"workflowId,omitempty"`
+	LogicAppResourceID *string `json:"logicAppResourceId,omitempty"`
+	TriggerURI         *string `json:"triggerUri,omitempty"`
+}
+
+// Get retrieves a single Alert Rule Action.
+func (client ActionsWorkaroundClient) Get(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, ruleID string, actionID string) (result Action, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, operationalInsightsResourceProvider, workspaceName, ruleID, actionID)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ActionsClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.ActionsClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ActionsClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,149 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ThreatIntelligenceIndicatorsWorkaroundClient creates, updates and retrieves Threat Intelligence Indicators using the
+// models below rather than those in the SDK, since the SDK defines the `phaseName` of a Kill Chain Phase as an integer
+// when the API uses a string - meaning that Indicators containing Kill Chain Phases can't be sent or unmarshalled
+type ThreatIntelligenceIndicatorsWorkaroundClient struct {
+	sdkClient *securityinsight.ThreatIntelligenceIndicatorClient
+}
+
+func NewThreatIntelligenceIndicatorsWorkaroundClient(client *securityinsight.ThreatIntelligenceIndicatorClient) ThreatIntelligenceIndicatorsWorkaroundClient {
+	return ThreatIntelligenceIndicatorsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type ThreatIntelligenceIndicator struct {
+	autorest.Response `json:"-"`
+	ID                *string                                `json:"id,omitempty"`
+	Name              *string                                `json:"name,omitempty"`
+	Etag              *string                                `json:"etag,omitempty"`
+	Kind              *string                                `json:"kind,omitempty"`
+	Properties        *ThreatIntelligenceIndicatorProperties `json:"properties,omitempty"`
+}
+
+type ThreatIntelligenceIndicatorProperties struct {
+	DisplayName        *string                             `json:"displayName,omitempty"`
+	Description        *string                             `json:"description,omitempty"`
+	Source             *string                             `json:"source,omitempty"`
+	Pattern            *string                             `json:"pattern,omitempty"`
+	PatternType        *string                             `json:"patternType,omitempty"`
+	Confidence         *int32                              `json:"confidence,omitempty"`
+	ValidFrom          *string                             `json:"validFrom,omitempty"`
+	ValidUntil         *string                             `json:"validUntil,omitempty"`
+	KillChainPhases    *[]ThreatIntelligenceKillChainPhase `json:"killChainPhases,omitempty"`
+	ThreatTypes        *[]string                           `json:"threatTypes,omitempty"`
+	Labels             *[]string                           `json:"labels,omitempty"`
+	ExternalID         *string                             `json:"externalId,omitempty"`
+	Revoked            *bool                               `json:"revoked,omitempty"`
+	Created            *string                             `json:"created,omitempty"`
+	LastUpdatedTimeUtc *string                             `json:"lastUpdatedTimeUtc,omitempty"`
+}
+
+type ThreatIntelligenceKillChainPhase struct {
+	KillChainName *string `json:"killChainName,omitempty"`
+	PhaseName     *string `json:"phaseName,omitempty"`
+}
+
+// Create creates a new Threat Intelligence Indicator, the name of which is generated by the API.
+func (client ThreatIntelligenceIndicatorsWorkaroundClient) Create(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, indicator ThreatIntelligenceIndicator) (result ThreatIntelligenceIndicator, err error) {
+	req, err := client.sdkClient.CreateIndicatorPreparer(ctx, resourceGroupName, operationalInsightsResourceProvider, workspaceName, securityinsight.ThreatIntelligenceIndicatorModelForRequestBody{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "CreateIndicator", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = autorest.Prepare(req, autorest.WithJSON(indicator))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "CreateIndicator", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.CreateIndicatorSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "CreateIndicator", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "CreateIndicator", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Update updates an existing Threat Intelligence Indicator.
+func (client ThreatIntelligenceIndicatorsWorkaroundClient) Update(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, name string, indicator ThreatIntelligenceIndicator) (result ThreatIntelligenceIndicator, err error) {
+	req, err := client.sdkClient.CreatePreparer(ctx, resourceGroupName, operationalInsightsResourceProvider, workspaceName, name, securityinsight.ThreatIntelligenceIndicatorModelForRequestBody{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Create", nil, "Failure preparing request")
+		return result, err
+	}
+
+	req, err = autorest.Prepare(req, autorest.WithJSON(indicator))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Create", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.CreateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Create", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Create", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Get retrieves a Threat Intelligence Indicator.
+func (client ThreatIntelligenceIndicatorsWorkaroundClient) Get(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, name string) (result ThreatIntelligenceIndicator, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, operationalInsightsResourceProvider, workspaceName, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (client ThreatIntelligenceIndicatorsWorkaroundClient) responder(resp *http.Response) (result ThreatIntelligenceIndicator, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -0,0 +1,136 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// WatchlistItemsWorkaroundClient exposes the Get and List operations for Watchlist Items, which are
+// available in the API but missing from the SDK - which only supports creating and deleting them
+type WatchlistItemsWorkaroundClient struct {
+	sdkClient *securityinsight.WatchlistItemClient
+}
+
+func NewWatchlistItemsWorkaroundClient(client *securityinsight.WatchlistItemClient) WatchlistItemsWorkaroundClient {
+	return WatchlistItemsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// WatchlistItemList is a page of Watchlist Items
+type WatchlistItemList struct {
+	autorest.Response `json:"-"`
+	NextLink          *string                          `json:"nextLink,omitempty"`
+	Value             *[]securityinsight.WatchlistItem `json:"value,omitempty"`
+}
+
+// Get retrieves a single Watchlist Item.
+func (client WatchlistItemsWorkaroundClient) Get(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, watchlistAlias string, watchlistItemID string) (result securityinsight.WatchlistItem, err error) {
+	pathParameters := map[string]interface{}{
+		"operationalInsightsResourceProvider": autorest.Encode("path", operationalInsightsResourceProvider),
+		"resourceGroupName":                   autorest.Encode("path", resourceGroupName),
+		"subscriptionId":                      autorest.Encode("path", client.sdkClient.SubscriptionID),
+		"watchlistAlias":                      autorest.Encode("path", watchlistAlias),
+		"watchlistItemId":                     autorest.Encode("path", watchlistItemID),
+		"workspaceName":                       autorest.Encode("path", workspaceName),
+	}
+
+	req, err := client.prepare(ctx, "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{operationalInsightsResourceProvider}/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/watchlists/{watchlistAlias}/watchlistItems/{watchlistItemId}", pathParameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "Get", nil, "Failure preparing request")
+		return result, err
+	}
+
+	resp, err := client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "Get", resp, "Failure sending request")
+		return result, err
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "Get", resp, "Failure responding to request")
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ListComplete retrieves all of the Watchlist Items within a Watchlist, following the `nextLink` of each page.
+func (client WatchlistItemsWorkaroundClient) ListComplete(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, watchlistAlias string) (result []securityinsight.WatchlistItem, err error) {
+	pathParameters := map[string]interface{}{
+		"operationalInsightsResourceProvider": autorest.Encode("path", operationalInsightsResourceProvider),
+		"resourceGroupName":                   autorest.Encode("path", resourceGroupName),
+		"subscriptionId":                      autorest.Encode("path", client.sdkClient.SubscriptionID),
+		"watchlistAlias":                      autorest.Encode("path", watchlistAlias),
+		"workspaceName":                       autorest.Encode("path", workspaceName),
+	}
+
+	req, err := client.prepare(ctx, "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{operationalInsightsResourceProvider}/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/watchlists/{watchlistAlias}/watchlistItems", pathParameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "List", nil, "Failure preparing request")
+		return nil, err
+	}
+
+	result = make([]securityinsight.WatchlistItem, 0)
+	for req != nil {
+		resp, err := client.sdkClient.CreateOrUpdateSender(req)
+		if err != nil {
+			err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "List", resp, "Failure sending request")
+			return nil, err
+		}
+
+		var page WatchlistItemList
+		err = autorest.Respond(
+			resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "List", resp, "Failure responding to request")
+			return nil, err
+		}
+
+		if page.Value != nil {
+			result = append(result, *page.Value...)
+		}
+
+		req = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+				autorest.AsJSON(),
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextLink))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "securityinsight.WatchlistItemClient", "List", nil, "Failure preparing next results request")
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (client WatchlistItemsWorkaroundClient) prepare(ctx context.Context, path string, pathParameters map[string]interface{}) (*http.Request, error) {
+	const APIVersion = "2019-01-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.sdkClient.BaseURI),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -6,12 +6,19 @@ import (
 )
 
 type Client struct {
-	AlertRulesClient         *securityinsight.AlertRulesClient
-	AlertRuleTemplatesClient *securityinsight.AlertRuleTemplatesClient
-	DataConnectorsClient     *securityinsight.DataConnectorsClient
+	ActionsClient                      *securityinsight.ActionsClient
+	AlertRulesClient                   *securityinsight.AlertRulesClient
+	AlertRuleTemplatesClient           *securityinsight.AlertRuleTemplatesClient
+	DataConnectorsClient               *securityinsight.DataConnectorsClient
+	ThreatIntelligenceIndicatorsClient *securityinsight.ThreatIntelligenceIndicatorClient
+	WatchlistsClient                   *securityinsight.WatchlistsClient
+	WatchlistItemsClient               *securityinsight.WatchlistItemClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	actionsClient := securityinsight.NewActionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&actionsClient.Client, o.ResourceManagerAuthorizer)
+
 	alertRulesClient := securityinsight.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&alertRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -21,9 +28,22 @@ func NewClient(o *common.ClientOptions) *Client {
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
+	threatIntelligenceIndicatorsClient := securityinsight.NewThreatIntelligenceIndicatorClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&threatIntelligenceIndicatorsClient.Client, o.ResourceManagerAuthorizer)
+
+	watchlistsClient := securityinsight.NewWatchlistsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&watchlistsClient.Client, o.ResourceManagerAuthorizer)
+
+	watchlistItemsClient := securityinsight.NewWatchlistItemClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&watchlistItemsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AlertRulesClient:         &alertRulesClient,
-		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
-		DataConnectorsClient:     &dataConnectorsClient,
+		ActionsClient:                      &actionsClient,
+		AlertRulesClient:                   &alertRulesClient,
+		AlertRuleTemplatesClient:           &alertRuleTemplatesClient,
+		DataConnectorsClient:               &dataConnectorsClient,
+		ThreatIntelligenceIndicatorsClient: &threatIntelligenceIndicatorsClient,
+		WatchlistsClient:                   &watchlistsClient,
+		WatchlistItemsClient:               &watchlistItemsClient,
 	}
 }
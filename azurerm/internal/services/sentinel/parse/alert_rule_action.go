@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type AlertRuleActionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	AlertRuleName  string
+	ActionName     string
+}
+
+func NewAlertRuleActionID(subscriptionId, resourceGroup, workspaceName, alertRuleName, actionName string) AlertRuleActionId {
+	return AlertRuleActionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		AlertRuleName:  alertRuleName,
+		ActionName:     actionName,
+	}
+}
+
+func (id AlertRuleActionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Action Name %q", id.ActionName),
+		fmt.Sprintf("Alert Rule Name %q", id.AlertRuleName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Alert Rule Action", segmentsStr)
+}
+
+func (id AlertRuleActionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/alertRules/%s/actions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.AlertRuleName, id.ActionName)
+}
+
+// AlertRuleActionID parses a AlertRuleAction ID into an AlertRuleActionId struct
+func AlertRuleActionID(input string) (*AlertRuleActionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AlertRuleActionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.AlertRuleName, err = id.PopSegment("alertRules"); err != nil {
+		return nil, err
+	}
+	if resourceId.ActionName, err = id.PopSegment("actions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
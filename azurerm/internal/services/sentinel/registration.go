@@ -29,6 +29,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
+		"azurerm_sentinel_alert_rule_action":                               resourceSentinelAlertRuleAction(),
 		"azurerm_sentinel_alert_rule_fusion":                               resourceSentinelAlertRuleFusion(),
 		"azurerm_sentinel_alert_rule_ms_security_incident":                 resourceSentinelAlertRuleMsSecurityIncident(),
 		"azurerm_sentinel_alert_rule_scheduled":                            resourceSentinelAlertRuleScheduled(),
@@ -39,5 +40,8 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_sentinel_data_connector_microsoft_cloud_app_security":     resourceSentinelDataConnectorMicrosoftCloudAppSecurity(),
 		"azurerm_sentinel_data_connector_office_365":                       resourceSentinelDataConnectorOffice365(),
 		"azurerm_sentinel_data_connector_threat_intelligence":              resourceSentinelDataConnectorThreatIntelligence(),
+		"azurerm_sentinel_threat_intelligence_indicator":                   resourceSentinelThreatIntelligenceIndicator(),
+		"azurerm_sentinel_watchlist":                                       resourceSentinelWatchlist(),
+		"azurerm_sentinel_watchlist_item":                                  resourceSentinelWatchlistItem(),
 	}
 }
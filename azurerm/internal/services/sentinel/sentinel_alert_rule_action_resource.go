@@ -0,0 +1,235 @@
+package sentinel
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	logicParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/parse"
+	logicValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceSentinelAlertRuleAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSentinelAlertRuleActionCreateUpdate,
+		Read:   resourceSentinelAlertRuleActionRead,
+		Update: resourceSentinelAlertRuleActionCreateUpdate,
+		Delete: resourceSentinelAlertRuleActionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AlertRuleActionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"sentinel_alert_rule_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AlertRuleID,
+			},
+
+			"logic_app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: logicValidate.WorkflowID,
+			},
+
+			// the callback URL of this Trigger is retrieved and used to run the Logic App,
+			// the name is then parsed from this callback URL (the `triggerUri`) when reading the Action
+			"trigger_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceSentinelAlertRuleActionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.ActionsClient
+	triggersClient := meta.(*clients.Client).Logic.WorkflowTriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	alertRuleId, err := parse.AlertRuleID(d.Get("sentinel_alert_rule_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewAlertRuleActionID(alertRuleId.SubscriptionId, alertRuleId.ResourceGroup, alertRuleId.WorkspaceName, alertRuleId.Name, d.Get("name").(string))
+
+	var etag *string
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.AlertRuleName, id.ActionName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_sentinel_alert_rule_action", id.ID())
+		}
+	} else {
+		// the Service avoids concurrent updates of this resource by checking the "etag" is the same value as the last Read
+		existing, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.AlertRuleName, id.ActionName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		etag = existing.Etag
+	}
+
+	logicAppId, err := logicParse.WorkflowID(d.Get("logic_app_id").(string))
+	if err != nil {
+		return err
+	}
+	triggerName := d.Get("trigger_name").(string)
+
+	callbackUrl, err := triggersClient.ListCallbackURL(ctx, logicAppId.ResourceGroup, logicAppId.Name, triggerName)
+	if err != nil {
+		return fmt.Errorf("retrieving the Callback URL for Trigger %q (Logic App %q / Resource Group %q): %+v", triggerName, logicAppId.Name, logicAppId.ResourceGroup, err)
+	}
+	if callbackUrl.Value == nil || *callbackUrl.Value == "" {
+		return fmt.Errorf("retrieving the Callback URL for Trigger %q (Logic App %q / Resource Group %q): `value` was nil", triggerName, logicAppId.Name, logicAppId.ResourceGroup)
+	}
+
+	params := securityinsight.ActionRequest{
+		ActionRequestProperties: &securityinsight.ActionRequestProperties{
+			LogicAppResourceID: utils.String(logicAppId.ID()),
+			TriggerURI:         callbackUrl.Value,
+		},
+		Etag: etag,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.AlertRuleName, id.ActionName, params); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSentinelAlertRuleActionRead(d, meta)
+}
+
+func resourceSentinelAlertRuleActionRead(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewActionsWorkaroundClient(meta.(*clients.Client).Sentinel.ActionsClient)
+	triggersClient := meta.(*clients.Client).Logic.WorkflowTriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AlertRuleActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.AlertRuleName, id.ActionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ActionName)
+	d.Set("sentinel_alert_rule_id", parse.NewAlertRuleID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.AlertRuleName).ID())
+
+	logicAppId := ""
+	triggerName := d.Get("trigger_name").(string)
+	if props := resp.Properties; props != nil {
+		if props.LogicAppResourceID != nil {
+			parsed, err := logicParse.WorkflowID(*props.LogicAppResourceID)
+			if err != nil {
+				return fmt.Errorf("parsing `logicAppResourceId` for %s: %+v", *id, err)
+			}
+			logicAppId = parsed.ID()
+
+			// when the `triggerUri` isn't returned (e.g. when importing) the Trigger can only be inferred when
+			// the Logic App has a single Trigger, which is the case for Logic Apps using the Sentinel Alert Trigger
+			if (props.TriggerURI == nil || *props.TriggerURI == "") && triggerName == "" {
+				triggers, err := triggersClient.List(ctx, parsed.ResourceGroup, parsed.Name, nil, "")
+				if err != nil {
+					return fmt.Errorf("listing Triggers for %s: %+v", *parsed, err)
+				}
+				if values := triggers.Values(); len(values) == 1 && values[0].Name != nil {
+					triggerName = *values[0].Name
+				}
+			}
+		}
+
+		if props.TriggerURI != nil && *props.TriggerURI != "" {
+			name, err := parseSentinelAlertRuleActionTriggerName(*props.TriggerURI)
+			if err != nil {
+				return fmt.Errorf("parsing `triggerUri` for %s: %+v", *id, err)
+			}
+			triggerName = name
+		}
+	}
+	d.Set("logic_app_id", logicAppId)
+	d.Set("trigger_name", triggerName)
+
+	return nil
+}
+
+// parseSentinelAlertRuleActionTriggerName parses the name of the Logic App Trigger from its callback URL, which
+// is in the format `https://{host}/workflows/{workflowId}/triggers/{triggerName}/paths/invoke?{query}`
+func parseSentinelAlertRuleActionTriggerName(input string) (string, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q as a URL: %+v", input, err)
+	}
+
+	segments := strings.Split(strings.Trim(uri.Path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "triggers") && segments[i+1] != "" {
+			return segments[i+1], nil
+		}
+	}
+
+	return "", fmt.Errorf("expected the path of %q to contain a `triggers` segment followed by the Trigger Name", uri.Path)
+}
+
+func resourceSentinelAlertRuleActionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.ActionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AlertRuleActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.AlertRuleName, id.ActionName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
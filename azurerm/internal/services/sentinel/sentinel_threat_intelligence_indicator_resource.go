@@ -0,0 +1,343 @@
+package sentinel
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Threat Intelligence Indicators are always created within the `main` Threat Intelligence container
+const threatIntelligenceName = "main"
+
+func resourceSentinelThreatIntelligenceIndicator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSentinelThreatIntelligenceIndicatorCreate,
+		Read:   resourceSentinelThreatIntelligenceIndicatorRead,
+		Update: resourceSentinelThreatIntelligenceIndicatorUpdate,
+		Delete: resourceSentinelThreatIntelligenceIndicatorDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ThreatIntelligenceIndicatorID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"pattern_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"domain-name",
+					"file",
+					"ipv4-addr",
+					"ipv6-addr",
+					"url",
+				}, false),
+			},
+
+			// the STIX pattern, e.g. `[ipv4-addr:value = '192.0.2.1']`
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"valid_from": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"valid_until": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"confidence": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"kill_chain_phase": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"phase_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"revoked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"threat_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSentinelThreatIntelligenceIndicatorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewThreatIntelligenceIndicatorsWorkaroundClient(meta.(*clients.Client).Sentinel.ThreatIntelligenceIndicatorsClient)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the name of the Indicator is generated by the API, so there's no requires import check
+	resp, err := client.Create(ctx, workspaceId.ResourceGroup, OperationalInsightsResourceProvider, workspaceId.WorkspaceName, expandSentinelThreatIntelligenceIndicator(d, nil))
+	if err != nil {
+		return fmt.Errorf("creating Sentinel Threat Intelligence Indicator (%s): %+v", workspaceId, err)
+	}
+
+	if resp.Name == nil || *resp.Name == "" {
+		return fmt.Errorf("empty or nil name returned for Sentinel Threat Intelligence Indicator (%s)", workspaceId)
+	}
+
+	id := parse.NewThreatIntelligenceIndicatorID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, threatIntelligenceName, *resp.Name)
+	d.SetId(id.ID())
+
+	return resourceSentinelThreatIntelligenceIndicatorRead(d, meta)
+}
+
+func resourceSentinelThreatIntelligenceIndicatorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewThreatIntelligenceIndicatorsWorkaroundClient(meta.(*clients.Client).Sentinel.ThreatIntelligenceIndicatorsClient)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ThreatIntelligenceIndicatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Service avoids concurrent updates of this resource by checking the "etag" is the same value as the last Read
+	existing, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IndicatorName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IndicatorName, expandSentinelThreatIntelligenceIndicator(d, existing.Etag)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceSentinelThreatIntelligenceIndicatorRead(d, meta)
+}
+
+func resourceSentinelThreatIntelligenceIndicatorRead(d *schema.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewThreatIntelligenceIndicatorsWorkaroundClient(meta.(*clients.Client).Sentinel.ThreatIntelligenceIndicatorsClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ThreatIntelligenceIndicatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IndicatorName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("log_analytics_workspace_id", loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if props := resp.Properties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("pattern_type", props.PatternType)
+		d.Set("pattern", props.Pattern)
+		d.Set("valid_from", props.ValidFrom)
+		d.Set("valid_until", props.ValidUntil)
+		d.Set("confidence", props.Confidence)
+		d.Set("description", props.Description)
+		d.Set("revoked", props.Revoked != nil && *props.Revoked)
+		d.Set("source", props.Source)
+		d.Set("created_on", props.Created)
+		d.Set("last_updated_on", props.LastUpdatedTimeUtc)
+
+		if err := d.Set("kill_chain_phase", flattenSentinelThreatIntelligenceIndicatorKillChainPhases(props.KillChainPhases)); err != nil {
+			return fmt.Errorf("setting `kill_chain_phase`: %+v", err)
+		}
+
+		if err := d.Set("threat_types", utils.FlattenStringSlice(props.ThreatTypes)); err != nil {
+			return fmt.Errorf("setting `threat_types`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSentinelThreatIntelligenceIndicatorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.ThreatIntelligenceIndicatorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ThreatIntelligenceIndicatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IndicatorName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandSentinelThreatIntelligenceIndicator(d *schema.ResourceData, etag *string) azuresdkhacks.ThreatIntelligenceIndicator {
+	props := &azuresdkhacks.ThreatIntelligenceIndicatorProperties{
+		DisplayName:     utils.String(d.Get("display_name").(string)),
+		PatternType:     utils.String(d.Get("pattern_type").(string)),
+		Pattern:         utils.String(d.Get("pattern").(string)),
+		ValidFrom:       utils.String(d.Get("valid_from").(string)),
+		Revoked:         utils.Bool(d.Get("revoked").(bool)),
+		KillChainPhases: expandSentinelThreatIntelligenceIndicatorKillChainPhases(d.Get("kill_chain_phase").([]interface{})),
+		ThreatTypes:     utils.ExpandStringSlice(d.Get("threat_types").([]interface{})),
+	}
+
+	if v := d.Get("valid_until").(string); v != "" {
+		props.ValidUntil = utils.String(v)
+	}
+
+	// `confidence` is a valid value when zero
+	// nolint staticcheck
+	if v, ok := d.GetOkExists("confidence"); ok {
+		props.Confidence = utils.Int32(int32(v.(int)))
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		props.Description = utils.String(v)
+	}
+
+	if v := d.Get("source").(string); v != "" {
+		props.Source = utils.String(v)
+	}
+
+	return azuresdkhacks.ThreatIntelligenceIndicator{
+		Kind:       utils.String("indicator"),
+		Etag:       etag,
+		Properties: props,
+	}
+}
+
+func expandSentinelThreatIntelligenceIndicatorKillChainPhases(input []interface{}) *[]azuresdkhacks.ThreatIntelligenceKillChainPhase {
+	output := make([]azuresdkhacks.ThreatIntelligenceKillChainPhase, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		output = append(output, azuresdkhacks.ThreatIntelligenceKillChainPhase{
+			KillChainName: utils.String(v["name"].(string)),
+			PhaseName:     utils.String(v["phase_name"].(string)),
+		})
+	}
+	return &output
+}
+
+func flattenSentinelThreatIntelligenceIndicatorKillChainPhases(input *[]azuresdkhacks.ThreatIntelligenceKillChainPhase) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.KillChainName != nil {
+			name = *item.KillChainName
+		}
+
+		phaseName := ""
+		if item.PhaseName != nil {
+			phaseName = *item.PhaseName
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":       name,
+			"phase_name": phaseName,
+		})
+	}
+
+	return output
+}
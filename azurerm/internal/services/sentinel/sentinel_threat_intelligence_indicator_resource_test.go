@@ -0,0 +1,136 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SentinelThreatIntelligenceIndicatorResource struct{}
+
+func TestAccSentinelThreatIntelligenceIndicator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := SentinelThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelThreatIntelligenceIndicator_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := SentinelThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kill_chain_phase.0.phase_name").HasValue("reconnaissance"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelThreatIntelligenceIndicator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := SentinelThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SentinelThreatIntelligenceIndicatorResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := azuresdkhacks.NewThreatIntelligenceIndicatorsWorkaroundClient(clients.Sentinel.ThreatIntelligenceIndicatorsClient)
+
+	id, err := parse.ThreatIntelligenceIndicatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, "Microsoft.OperationalInsights", id.WorkspaceName, id.IndicatorName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r SentinelThreatIntelligenceIndicatorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "acctest-indicator-%d"
+  pattern_type               = "ipv4-addr"
+  pattern                    = "[ipv4-addr:value = '192.0.2.1']"
+  valid_from                 = "2021-01-01T00:00:00Z"
+}
+`, SentinelWatchlistResource{}.template(data), data.RandomInteger)
+}
+
+func (r SentinelThreatIntelligenceIndicatorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "acctest-indicator-%d"
+  description                = "Acceptance Test Indicator"
+  pattern_type               = "ipv4-addr"
+  pattern                    = "[ipv4-addr:value = '192.0.2.1']"
+  valid_from                 = "2021-01-01T00:00:00Z"
+  valid_until                = "2030-01-01T00:00:00Z"
+  confidence                 = 80
+  source                     = "Acceptance Tests"
+  threat_types               = ["malicious-activity"]
+
+  kill_chain_phase {
+    name       = "lockheed-martin-cyber-kill-chain"
+    phase_name = "reconnaissance"
+  }
+}
+`, SentinelWatchlistResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,145 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SentinelWatchlistItemResource struct{}
+
+func TestAccSentinelWatchlistItem_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist_item", "test")
+	r := SentinelWatchlistItemResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWatchlistItem_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist_item", "test")
+	r := SentinelWatchlistItemResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("properties.Department").HasValue("Security"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWatchlistItem_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist_item", "test")
+	r := SentinelWatchlistItemResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SentinelWatchlistItemResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := azuresdkhacks.NewWatchlistItemsWorkaroundClient(clients.Sentinel.WatchlistItemsClient)
+
+	id, err := parse.WatchlistItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, "Microsoft.OperationalInsights", id.WorkspaceName, id.WatchlistName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r SentinelWatchlistItemResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist_item" "test" {
+  name         = "196abd06-eb6c-4aad-8ae9-7c7b0fd8da5a"
+  watchlist_id = azurerm_sentinel_watchlist.test.id
+  properties = {
+    UserPrincipalName = "alice@example.com"
+    Department        = "Finance"
+  }
+}
+`, r.template(data))
+}
+
+func (r SentinelWatchlistItemResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist_item" "test" {
+  name         = "196abd06-eb6c-4aad-8ae9-7c7b0fd8da5a"
+  watchlist_id = azurerm_sentinel_watchlist.test.id
+  properties = {
+    UserPrincipalName = "alice@example.com"
+    Department        = "Security"
+  }
+}
+`, r.template(data))
+}
+
+func (r SentinelWatchlistItemResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist_item" "import" {
+  name         = azurerm_sentinel_watchlist_item.test.name
+  watchlist_id = azurerm_sentinel_watchlist_item.test.watchlist_id
+  properties   = azurerm_sentinel_watchlist_item.test.properties
+}
+`, r.basic(data))
+}
+
+func (r SentinelWatchlistItemResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "acctestSW-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "test"
+}
+`, SentinelWatchlistResource{}.template(data), data.RandomInteger)
+}
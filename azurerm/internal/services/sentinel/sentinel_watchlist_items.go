@@ -0,0 +1,209 @@
+package sentinel
+
+import (
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+)
+
+// sentinelWatchlistItems is the parsed representation of the CSV content used to manage the
+// items within a Sentinel Watchlist in bulk, where each item is identified by the value of
+// the column used as the Search Key
+type sentinelWatchlistItems struct {
+	Columns []string
+	Rows    []map[string]string
+}
+
+func parseSentinelWatchlistItemsCSV(input string, searchKey string) (*sentinelWatchlistItems, error) {
+	records, err := csv.NewReader(strings.NewReader(input)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing CSV content: %+v", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("the CSV content must contain a header row")
+	}
+
+	columns := make([]string, 0)
+	for _, column := range records[0] {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			return nil, fmt.Errorf("the header row of the CSV content contains an empty column name")
+		}
+		for _, existing := range columns {
+			if existing == column {
+				return nil, fmt.Errorf("the header row of the CSV content contains the column %q more than once", column)
+			}
+		}
+		columns = append(columns, column)
+	}
+
+	found := false
+	for _, column := range columns {
+		if column == searchKey {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("the search key %q was not found in the header row of the CSV content", searchKey)
+	}
+
+	rows := make([]map[string]string, 0)
+	keys := make(map[string]struct{})
+	for i, record := range records[1:] {
+		row := make(map[string]string)
+		for j, column := range columns {
+			row[column] = record[j]
+		}
+
+		key := row[searchKey]
+		if key == "" {
+			return nil, fmt.Errorf("row %d of the CSV content has no value for the search key %q", i+1, searchKey)
+		}
+		if _, exists := keys[key]; exists {
+			return nil, fmt.Errorf("row %d of the CSV content contains the duplicate value %q for the search key %q", i+1, key, searchKey)
+		}
+		keys[key] = struct{}{}
+
+		rows = append(rows, row)
+	}
+
+	return &sentinelWatchlistItems{
+		Columns: columns,
+		Rows:    rows,
+	}, nil
+}
+
+func flattenSentinelWatchlistItemsCSV(input sentinelWatchlistItems) (string, error) {
+	builder := &strings.Builder{}
+	writer := csv.NewWriter(builder)
+
+	if err := writer.Write(input.Columns); err != nil {
+		return "", err
+	}
+	for _, row := range input.Rows {
+		record := make([]string, 0)
+		for _, column := range input.Columns {
+			record = append(record, row[column])
+		}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
+// sentinelWatchlistItemsFromAzure builds the items from those which exist in Azure, keeping the
+// columns and order of the items which are defined in the configuration so that these can be compared
+func sentinelWatchlistItemsFromAzure(configured sentinelWatchlistItems, searchKey string, items []securityinsight.WatchlistItem) sentinelWatchlistItems {
+	existing := make(map[string]map[string]string)
+	extraColumns := make(map[string]struct{})
+	for _, item := range items {
+		values := sentinelWatchlistItemValues(item)
+		key, ok := values[searchKey]
+		if !ok || key == "" {
+			continue
+		}
+		existing[key] = values
+
+		for column := range values {
+			extraColumns[column] = struct{}{}
+		}
+	}
+
+	columns := make([]string, 0)
+	for _, column := range configured.Columns {
+		columns = append(columns, column)
+		delete(extraColumns, column)
+	}
+	extra := make([]string, 0)
+	for column := range extraColumns {
+		extra = append(extra, column)
+	}
+	sort.Strings(extra)
+	columns = append(columns, extra...)
+
+	rows := make([]map[string]string, 0)
+	for _, row := range configured.Rows {
+		key := row[searchKey]
+		if values, ok := existing[key]; ok {
+			rows = append(rows, sentinelWatchlistItemRow(columns, values))
+			delete(existing, key)
+		}
+	}
+	remaining := make([]string, 0)
+	for key := range existing {
+		remaining = append(remaining, key)
+	}
+	sort.Strings(remaining)
+	for _, key := range remaining {
+		rows = append(rows, sentinelWatchlistItemRow(columns, existing[key]))
+	}
+
+	return sentinelWatchlistItems{
+		Columns: columns,
+		Rows:    rows,
+	}
+}
+
+func sentinelWatchlistItemsEqual(first sentinelWatchlistItems, second sentinelWatchlistItems) bool {
+	return reflect.DeepEqual(first.Columns, second.Columns) && reflect.DeepEqual(first.Rows, second.Rows)
+}
+
+// sentinelWatchlistItemMatchesRow returns whether the values of an existing item match the row, ignoring
+// any columns which are empty in one and absent in the other
+func sentinelWatchlistItemMatchesRow(columns []string, values map[string]string, row map[string]string) bool {
+	for column, value := range values {
+		if _, ok := row[column]; !ok && value != "" {
+			return false
+		}
+	}
+
+	return reflect.DeepEqual(sentinelWatchlistItemRow(columns, values), row)
+}
+
+func sentinelWatchlistItemRow(columns []string, values map[string]string) map[string]string {
+	row := make(map[string]string)
+	for _, column := range columns {
+		row[column] = values[column]
+	}
+	return row
+}
+
+// sentinelWatchlistItemValues returns the key-value pairs of a Watchlist Item, which are untyped in the API
+func sentinelWatchlistItemValues(item securityinsight.WatchlistItem) map[string]string {
+	values := make(map[string]string)
+	if item.WatchlistItemProperties == nil {
+		return values
+	}
+
+	if raw, ok := item.WatchlistItemProperties.ItemsKeyValue.(map[string]interface{}); ok {
+		for k, v := range raw {
+			if v == nil {
+				values[k] = ""
+				continue
+			}
+			values[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return values
+}
+
+func expandSentinelWatchlistItemValues(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
+}
@@ -0,0 +1,143 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+)
+
+func TestParseSentinelWatchlistItemsCSV(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Input     string
+		SearchKey string
+		Rows      int
+		Error     bool
+	}{
+		{
+			Name:      "Header Only",
+			Input:     "Name,Department\n",
+			SearchKey: "Name",
+			Rows:      0,
+		},
+		{
+			Name:      "Multiple Rows",
+			Input:     "Name,Department\nalice,Finance\nbob,\"Research, Development\"\n",
+			SearchKey: "Name",
+			Rows:      2,
+		},
+		{
+			Name:      "Empty",
+			Input:     "",
+			SearchKey: "Name",
+			Error:     true,
+		},
+		{
+			Name:      "Search Key Not In Header",
+			Input:     "Name,Department\nalice,Finance\n",
+			SearchKey: "UserPrincipalName",
+			Error:     true,
+		},
+		{
+			Name:      "Duplicate Column",
+			Input:     "Name,Name\nalice,bob\n",
+			SearchKey: "Name",
+			Error:     true,
+		},
+		{
+			Name:      "Duplicate Search Key Value",
+			Input:     "Name,Department\nalice,Finance\nalice,Security\n",
+			SearchKey: "Name",
+			Error:     true,
+		},
+		{
+			Name:      "Empty Search Key Value",
+			Input:     "Name,Department\n,Finance\n",
+			SearchKey: "Name",
+			Error:     true,
+		},
+		{
+			Name:      "Mismatched Number Of Fields",
+			Input:     "Name,Department\nalice\n",
+			SearchKey: "Name",
+			Error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseSentinelWatchlistItemsCSV(v.Input, v.SearchKey)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if len(actual.Rows) != v.Rows {
+			t.Fatalf("Expected %d rows but got %d", v.Rows, len(actual.Rows))
+		}
+	}
+}
+
+func TestSentinelWatchlistItemsFromAzure(t *testing.T) {
+	configured, err := parseSentinelWatchlistItemsCSV("Name,Department\nbob,Engineering\nalice,Finance\n", "Name")
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	item := func(values map[string]interface{}) securityinsight.WatchlistItem {
+		return securityinsight.WatchlistItem{
+			WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+				ItemsKeyValue: values,
+			},
+		}
+	}
+
+	// the order in which the items are returned shouldn't matter
+	actual := sentinelWatchlistItemsFromAzure(*configured, "Name", []securityinsight.WatchlistItem{
+		item(map[string]interface{}{"Name": "alice", "Department": "Finance"}),
+		item(map[string]interface{}{"Name": "bob", "Department": "Engineering"}),
+	})
+	if !sentinelWatchlistItemsEqual(*configured, actual) {
+		t.Fatalf("Expected the items to be equal but got %+v", actual)
+	}
+
+	// an item which has been changed, removed and added outside of Terraform should be detected
+	actual = sentinelWatchlistItemsFromAzure(*configured, "Name", []securityinsight.WatchlistItem{
+		item(map[string]interface{}{"Name": "bob", "Department": "Security"}),
+		item(map[string]interface{}{"Name": "carol", "Department": "Finance", "Location": "London"}),
+	})
+	if sentinelWatchlistItemsEqual(*configured, actual) {
+		t.Fatalf("Expected the items to differ")
+	}
+
+	expected := "Name,Department,Location\nbob,Security,\ncarol,Finance,London\n"
+	output, err := flattenSentinelWatchlistItemsCSV(actual)
+	if err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+	if output != expected {
+		t.Fatalf("Expected %q but got %q", expected, output)
+	}
+}
+
+func TestSentinelWatchlistItemMatchesRow(t *testing.T) {
+	columns := []string{"Name", "Department"}
+	row := map[string]string{"Name": "alice", "Department": ""}
+
+	if !sentinelWatchlistItemMatchesRow(columns, map[string]string{"Name": "alice"}, row) {
+		t.Fatalf("Expected an absent value to match an empty value")
+	}
+	if sentinelWatchlistItemMatchesRow(columns, map[string]string{"Name": "alice", "Department": "Finance"}, row) {
+		t.Fatalf("Expected a changed value not to match")
+	}
+	if sentinelWatchlistItemMatchesRow(columns, map[string]string{"Name": "alice", "Location": "London"}, row) {
+		t.Fatalf("Expected an additional value not to match")
+	}
+}
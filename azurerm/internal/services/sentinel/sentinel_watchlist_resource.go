@@ -0,0 +1,322 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceSentinelWatchlist() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSentinelWatchlistCreateUpdate,
+		Read:   resourceSentinelWatchlistRead,
+		Update: resourceSentinelWatchlistCreateUpdate,
+		Delete: resourceSentinelWatchlistDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.WatchlistID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"labels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"default_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azValidate.ISO8601Duration,
+			},
+
+			// the name of the column which uniquely identifies each item within `items_csv_content`
+			"item_search_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"items_csv_content"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"items_csv_content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"item_search_key"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceSentinelWatchlistCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.WatchlistsClient
+	itemsClient := azuresdkhacks.NewWatchlistItemsWorkaroundClient(meta.(*clients.Client).Sentinel.WatchlistItemsClient)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewWatchlistID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, d.Get("name").(string))
+
+	// the CSV content is validated up-front, since the Search Key isn't known at validation time
+	var items *sentinelWatchlistItems
+	searchKey := d.Get("item_search_key").(string)
+	if v := d.Get("items_csv_content").(string); v != "" {
+		items, err = parseSentinelWatchlistItemsCSV(v, searchKey)
+		if err != nil {
+			return fmt.Errorf("parsing `items_csv_content`: %+v", err)
+		}
+	}
+
+	var etag *string
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_sentinel_watchlist", id.ID())
+		}
+	} else {
+		// the Service avoids concurrent updates of this resource by checking the "etag" is the same value as the last Read
+		existing, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		etag = existing.Etag
+	}
+
+	params := securityinsight.Watchlist{
+		WatchlistProperties: &securityinsight.WatchlistProperties{
+			DisplayName: utils.String(d.Get("display_name").(string)),
+			// the only supported Provider is `Microsoft`
+			Provider: utils.String("Microsoft"),
+			// items are managed individually rather than uploaded, however a Source must still be specified
+			Source: securityinsight.Localfile,
+			Labels: utils.ExpandStringSlice(d.Get("labels").([]interface{})),
+		},
+		Etag: etag,
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		params.WatchlistProperties.Description = utils.String(v)
+	}
+
+	if v := d.Get("default_duration").(string); v != "" {
+		params.WatchlistProperties.DefaultDuration = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name, params); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if items != nil && (d.IsNewResource() || d.HasChanges("item_search_key", "items_csv_content")) {
+		if err := applySentinelWatchlistItems(ctx, meta.(*clients.Client).Sentinel.WatchlistItemsClient, itemsClient, id, searchKey, *items); err != nil {
+			return fmt.Errorf("updating the items within %s: %+v", id, err)
+		}
+	}
+
+	return resourceSentinelWatchlistRead(d, meta)
+}
+
+func resourceSentinelWatchlistRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.WatchlistsClient
+	itemsClient := azuresdkhacks.NewWatchlistItemsWorkaroundClient(meta.(*clients.Client).Sentinel.WatchlistItemsClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.WatchlistID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("log_analytics_workspace_id", loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if props := resp.WatchlistProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("default_duration", props.DefaultDuration)
+		if err := d.Set("labels", utils.FlattenStringSlice(props.Labels)); err != nil {
+			return fmt.Errorf("setting `labels`: %+v", err)
+		}
+	}
+
+	// the items are only tracked when they're managed in bulk - since the Search Key isn't exposed by the API
+	// the CSV content defined in the configuration is used to determine the columns and order of the items
+	searchKey := d.Get("item_search_key").(string)
+	csvContent := d.Get("items_csv_content").(string)
+	if searchKey != "" && csvContent != "" {
+		configured, err := parseSentinelWatchlistItemsCSV(csvContent, searchKey)
+		if err != nil {
+			return fmt.Errorf("parsing `items_csv_content`: %+v", err)
+		}
+
+		existing, err := itemsClient.ListComplete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+		if err != nil {
+			return fmt.Errorf("listing the items within %s: %+v", *id, err)
+		}
+
+		actual := sentinelWatchlistItemsFromAzure(*configured, searchKey, existing)
+		if !sentinelWatchlistItemsEqual(*configured, actual) {
+			csvContent, err = flattenSentinelWatchlistItemsCSV(actual)
+			if err != nil {
+				return fmt.Errorf("flattening `items_csv_content`: %+v", err)
+			}
+		}
+		d.Set("items_csv_content", csvContent)
+	}
+
+	return nil
+}
+
+func resourceSentinelWatchlistDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.WatchlistsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.WatchlistID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// applySentinelWatchlistItems diffs the items within the Watchlist against those defined in the CSV content using
+// the Search Key - creating items which don't exist, updating those whose values differ and deleting any others
+func applySentinelWatchlistItems(ctx context.Context, client *securityinsight.WatchlistItemClient, itemsClient azuresdkhacks.WatchlistItemsWorkaroundClient, id parse.WatchlistId, searchKey string, desired sentinelWatchlistItems) error {
+	existingItems, err := itemsClient.ListComplete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing items: %+v", err)
+	}
+
+	existing := make(map[string]securityinsight.WatchlistItem)
+	unmatched := make([]string, 0)
+	for _, item := range existingItems {
+		if item.Name == nil {
+			continue
+		}
+
+		key, ok := sentinelWatchlistItemValues(item)[searchKey]
+		if !ok || key == "" {
+			unmatched = append(unmatched, *item.Name)
+			continue
+		}
+		existing[key] = item
+	}
+
+	for _, row := range desired.Rows {
+		key := row[searchKey]
+
+		itemName := ""
+		if item, ok := existing[key]; ok {
+			delete(existing, key)
+
+			if sentinelWatchlistItemMatchesRow(desired.Columns, sentinelWatchlistItemValues(item), row) {
+				continue
+			}
+			itemName = *item.Name
+		} else {
+			itemName, err = uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating an ID for the item %q: %+v", key, err)
+			}
+		}
+
+		params := securityinsight.WatchlistItem{
+			WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+				ItemsKeyValue: expandSentinelWatchlistItemValues(row),
+			},
+		}
+		if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name, itemName, params); err != nil {
+			return fmt.Errorf("creating/updating the item %q: %+v", key, err)
+		}
+	}
+
+	for _, item := range existing {
+		unmatched = append(unmatched, *item.Name)
+	}
+	for _, itemName := range unmatched {
+		if _, err := client.Delete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name, itemName); err != nil {
+			return fmt.Errorf("deleting the item %q: %+v", itemName, err)
+		}
+	}
+
+	return nil
+}
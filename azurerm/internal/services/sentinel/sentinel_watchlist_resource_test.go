@@ -0,0 +1,225 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SentinelWatchlistResource struct{}
+
+func TestAccSentinelWatchlist_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := SentinelWatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWatchlist_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := SentinelWatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWatchlist_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := SentinelWatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWatchlist_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := SentinelWatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSentinelWatchlist_items(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := SentinelWatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.items(data, `UserPrincipalName,Department
+alice@example.com,Finance
+bob@example.com,Engineering
+`),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("item_search_key", "items_csv_content"),
+		{
+			// updates an item, removes an item and adds an item
+			Config: r.items(data, `UserPrincipalName,Department
+alice@example.com,Security
+carol@example.com,Engineering
+`),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("item_search_key", "items_csv_content"),
+	})
+}
+
+func (r SentinelWatchlistResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.WatchlistID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.WatchlistsClient.Get(ctx, id.ResourceGroup, "Microsoft.OperationalInsights", id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r SentinelWatchlistResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "acctestSW-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "test"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelWatchlistResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "acctestSW-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "updated"
+  description                = "Acceptance Test Watchlist"
+  labels                     = ["label1", "label2"]
+  default_duration           = "P2DT3H"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelWatchlistResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "import" {
+  name                       = azurerm_sentinel_watchlist.test.name
+  log_analytics_workspace_id = azurerm_sentinel_watchlist.test.log_analytics_workspace_id
+  display_name               = azurerm_sentinel_watchlist.test.display_name
+}
+`, r.basic(data))
+}
+
+func (r SentinelWatchlistResource) items(data acceptance.TestData, content string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "acctestSW-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "test"
+  item_search_key            = "UserPrincipalName"
+  items_csv_content          = <<CSV
+%sCSV
+}
+`, r.template(data), data.RandomInteger, content)
+}
+
+func (r SentinelWatchlistResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_solution" "test" {
+  solution_name         = "SecurityInsights"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  workspace_resource_id = azurerm_log_analytics_workspace.test.id
+  workspace_name        = azurerm_log_analytics_workspace.test.name
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/SecurityInsights"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,250 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/shim"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+type blobDirectoryFile struct {
+	// Path is the path to the file on disk
+	Path string
+
+	// ContentMD5 is the hex-encoded MD5 hash of the file's contents
+	ContentMD5 string
+}
+
+// BlobDirectorySync synchronises the files within a local directory to the Blobs beneath a prefix
+// within a Storage Container - uploading new and changed files and removing Blobs which no longer exist locally
+type BlobDirectorySync struct {
+	BlobsClient      *blobs.Client
+	ContainersClient shim.StorageContainerWrapper
+
+	AccountName   string
+	ContainerName string
+	ResourceGroup string
+
+	Parallelism int
+	Prefix      string
+	Source      string
+}
+
+func (bds BlobDirectorySync) Sync(ctx context.Context) error {
+	local, err := buildBlobDirectoryManifest(bds.Source)
+	if err != nil {
+		return err
+	}
+
+	remote, err := listBlobDirectory(ctx, bds.ContainersClient, bds.ResourceGroup, bds.AccountName, bds.ContainerName, bds.Prefix)
+	if err != nil {
+		return err
+	}
+
+	// files are compared using their MD5, so that only new and changed files are uploaded
+	toUpload := make([]string, 0)
+	for name, file := range local {
+		if existing, ok := remote[name]; ok && strings.EqualFold(existing, file.ContentMD5) {
+			continue
+		}
+		toUpload = append(toUpload, name)
+	}
+	sort.Strings(toUpload)
+
+	if err := bds.upload(ctx, local, toUpload); err != nil {
+		return err
+	}
+
+	for name := range remote {
+		if _, ok := local[name]; ok {
+			continue
+		}
+
+		blobName := blobDirectoryBlobName(bds.Prefix, name)
+		input := blobs.DeleteInput{
+			DeleteSnapshots: true,
+		}
+		if _, err := bds.BlobsClient.Delete(ctx, bds.AccountName, bds.ContainerName, blobName, input); err != nil {
+			return fmt.Errorf("deleting Blob %q (Container %q / Account %q): %s", blobName, bds.ContainerName, bds.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+func (bds BlobDirectorySync) upload(ctx context.Context, manifest map[string]blobDirectoryFile, names []string) error {
+	err := uploadInParallel(bds.Parallelism, len(names), func(i int) error {
+		return bds.uploadFile(ctx, names[i], manifest[names[i]])
+	})
+	if err != nil {
+		return fmt.Errorf("uploading the contents of %q: %s", bds.Source, err)
+	}
+
+	return nil
+}
+
+func (bds BlobDirectorySync) uploadFile(ctx context.Context, name string, file blobDirectoryFile) error {
+	contentType, err := detectBlobDirectoryContentType(file.Path)
+	if err != nil {
+		return err
+	}
+
+	// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
+	contentMD5, err := convertHexToBase64Encoding(file.ContentMD5)
+	if err != nil {
+		return err
+	}
+
+	blobName := blobDirectoryBlobName(bds.Prefix, name)
+	upload := BlobUpload{
+		AccountName:   bds.AccountName,
+		ContainerName: bds.ContainerName,
+		BlobName:      blobName,
+		Client:        bds.BlobsClient,
+
+		BlobType:    "block",
+		ContentType: contentType,
+		ContentMD5:  contentMD5,
+		Source:      file.Path,
+	}
+	if err := upload.Create(ctx); err != nil {
+		return fmt.Errorf("uploading %q to Blob %q (Container %q / Account %q): %s", file.Path, blobName, bds.ContainerName, bds.AccountName, err)
+	}
+
+	return nil
+}
+
+// buildBlobDirectoryManifest walks the directory at `source` and returns the files within it, keyed by
+// their path relative to `source` using forward slashes, which is also the Blob name beneath the prefix
+func buildBlobDirectoryManifest(source string) (map[string]blobDirectoryFile, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory %q: %s", source, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source %q is not a directory", source)
+	}
+
+	manifest := make(map[string]blobDirectoryFile)
+	err = filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+
+		contentMD5, err := hashBlobDirectoryFile(path)
+		if err != nil {
+			return err
+		}
+
+		manifest[filepath.ToSlash(relativePath)] = blobDirectoryFile{
+			Path:       path,
+			ContentMD5: contentMD5,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building manifest for source directory %q: %s", source, err)
+	}
+
+	return manifest, nil
+}
+
+func hashBlobDirectoryFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %s", path, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("hashing %q: %s", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// detectBlobDirectoryContentType determines the Content Type from the file extension, falling back to
+// sniffing the contents of the file when the extension isn't recognised
+func detectBlobDirectoryContentType(path string) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		return contentType, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %s", path, err)
+	}
+	defer file.Close()
+
+	buffer := make([]byte, 512)
+	n, err := file.Read(buffer)
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("reading %q: %s", path, err)
+	}
+
+	return http.DetectContentType(buffer[:n]), nil
+}
+
+// listBlobDirectory returns the hex-encoded MD5 of each Blob beneath the prefix, keyed by the Blob name relative to the prefix
+func listBlobDirectory(ctx context.Context, client shim.StorageContainerWrapper, resourceGroup, accountName, containerName, prefix string) (map[string]string, error) {
+	listPrefix := blobDirectoryBlobName(prefix, "")
+	blobList, err := client.ListBlobs(ctx, resourceGroup, accountName, containerName, listPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("listing Blobs with the prefix %q (Container %q / Account %q): %s", listPrefix, containerName, accountName, err)
+	}
+
+	results := make(map[string]string)
+	for _, blob := range *blobList {
+		contentMD5 := ""
+		if blob.Properties != nil && blob.Properties.ContentMD5 != nil && *blob.Properties.ContentMD5 != "" {
+			contentMD5, err = convertBase64ToHexEncoding(*blob.Properties.ContentMD5)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		results[strings.TrimPrefix(blob.Name, listPrefix)] = contentMD5
+	}
+
+	return results, nil
+}
+
+func blobDirectoryBlobName(prefix, name string) string {
+	return fmt.Sprintf("%s/%s", prefix, name)
+}
+
+// blobDirectoryManifestHash returns a single hash representing the names and MD5's of every file in the directory
+func blobDirectoryManifestHash(files map[string]string) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(fmt.Sprintf("%s:%s\n", name, files[name])))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -227,8 +227,6 @@ type storageBlobPage struct {
 }
 
 func (sbu BlobUpload) pageUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
-	workerCount := sbu.Parallelism * runtime.NumCPU()
-
 	// first we chunk the file and assign them to 'pages'
 	pageList, err := sbu.storageBlobPageSplit(file, fileSize)
 	if err != nil {
@@ -236,31 +234,46 @@ func (sbu BlobUpload) pageUploadFromSource(ctx context.Context, file io.ReaderAt
 	}
 
 	// finally we upload the contents of said file
-	pages := make(chan storageBlobPage, len(pageList))
-	errors := make(chan error, len(pageList))
+	err = uploadInParallel(sbu.Parallelism, len(pageList), func(i int) error {
+		return sbu.blobPageUpload(ctx, pageList[i], fileSize)
+	})
+	if err != nil {
+		return fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, err)
+	}
+
+	return nil
+}
+
+// uploadInParallel calls `upload` for each of the `count` items using `parallelism` workers per CPU,
+// returning the first error (if any) once every item has been attempted
+func uploadInParallel(parallelism int, count int, upload func(i int) error) error {
+	workerCount := parallelism * runtime.NumCPU()
+
+	items := make(chan int, count)
+	errors := make(chan error, count)
 	wg := &sync.WaitGroup{}
-	wg.Add(len(pageList))
+	wg.Add(count)
 
-	total := int64(0)
-	for _, page := range pageList {
-		total += page.section.Size()
-		pages <- page
+	for i := 0; i < count; i++ {
+		items <- i
 	}
-	close(pages)
+	close(items)
 
 	for i := 0; i < workerCount; i++ {
-		go sbu.blobPageUploadWorker(ctx, blobPageUploadContext{
-			blobSize: fileSize,
-			pages:    pages,
-			errors:   errors,
-			wg:       wg,
-		})
+		go func() {
+			for item := range items {
+				if err := upload(item); err != nil {
+					errors <- err
+				}
+				wg.Done()
+			}
+		}()
 	}
 
 	wg.Wait()
 
 	if len(errors) > 0 {
-		return fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, <-errors)
+		return <-errors
 	}
 
 	return nil
@@ -324,43 +337,30 @@ func (sbu BlobUpload) storageBlobPageSplit(file io.ReaderAt, fileSize int64) ([]
 	return pages, nil
 }
 
-type blobPageUploadContext struct {
-	blobSize int64
-	pages    chan storageBlobPage
-	errors   chan error
-	wg       *sync.WaitGroup
-}
-
-func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPageUploadContext) {
-	for page := range uploadCtx.pages {
-		start := page.offset
-		end := page.offset + page.section.Size() - 1
-		if end > uploadCtx.blobSize-1 {
-			end = uploadCtx.blobSize - 1
-		}
-		size := end - start + 1
-
-		chunk := make([]byte, size)
-		if _, err := page.section.Read(chunk); err != nil && err != io.EOF {
-			uploadCtx.errors <- fmt.Errorf("Error reading source file %q at offset %d: %s", sbu.Source, page.offset, err)
-			uploadCtx.wg.Done()
-			continue
-		}
+func (sbu BlobUpload) blobPageUpload(ctx context.Context, page storageBlobPage, blobSize int64) error {
+	start := page.offset
+	end := page.offset + page.section.Size() - 1
+	if end > blobSize-1 {
+		end = blobSize - 1
+	}
+	size := end - start + 1
 
-		input := blobs.PutPageUpdateInput{
-			StartByte: start,
-			EndByte:   end,
-			Content:   chunk,
-		}
+	chunk := make([]byte, size)
+	if _, err := page.section.Read(chunk); err != nil && err != io.EOF {
+		return fmt.Errorf("Error reading source file %q at offset %d: %s", sbu.Source, page.offset, err)
+	}
 
-		if _, err := sbu.Client.PutPageUpdate(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
-			uploadCtx.errors <- fmt.Errorf("Error writing page at offset %d for file %q: %s", page.offset, sbu.Source, err)
-			uploadCtx.wg.Done()
-			continue
-		}
+	input := blobs.PutPageUpdateInput{
+		StartByte: start,
+		EndByte:   end,
+		Content:   chunk,
+	}
 
-		uploadCtx.wg.Done()
+	if _, err := sbu.Client.PutPageUpdate(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error writing page at offset %d for file %q: %s", page.offset, sbu.Source, err)
 	}
+
+	return nil
 }
 
 func convertHexToBase64Encoding(str string) (string, error) {
@@ -24,17 +24,21 @@ import (
 )
 
 type Client struct {
-	AccountsClient           *storage.AccountsClient
-	FileSystemsClient        *filesystems.Client
-	ADLSGen2PathsClient      *paths.Client
-	ManagementPoliciesClient *storage.ManagementPoliciesClient
-	BlobServicesClient       *storage.BlobServicesClient
-	CloudEndpointsClient     *storagesync.CloudEndpointsClient
-	EncryptionScopesClient   *storage.EncryptionScopesClient
-	Environment              az.Environment
-	SyncServiceClient        *storagesync.ServicesClient
-	SyncGroupsClient         *storagesync.SyncGroupsClient
-	SubscriptionId           string
+	AccountsClient                  *storage.AccountsClient
+	FileSystemsClient               *filesystems.Client
+	ADLSGen2PathsClient             *paths.Client
+	ManagementPoliciesClient        *storage.ManagementPoliciesClient
+	ObjectReplicationPoliciesClient *storage.ObjectReplicationPoliciesClient
+	BlobServicesClient              *storage.BlobServicesClient
+	BlobContainersClient            *storage.BlobContainersClient
+	BlobInventoryPoliciesClient     *storage.BlobInventoryPoliciesClient
+	CloudEndpointsClient            *storagesync.CloudEndpointsClient
+	EncryptionScopesClient          *storage.EncryptionScopesClient
+	FileServicesClient              *storage.FileServicesClient
+	Environment                     az.Environment
+	SyncServiceClient               *storagesync.ServicesClient
+	SyncGroupsClient                *storagesync.SyncGroupsClient
+	SubscriptionId                  string
 
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
@@ -53,15 +57,27 @@ func NewClient(options *common.ClientOptions) *Client {
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
+	objectReplicationPoliciesClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&objectReplicationPoliciesClient.Client, options.ResourceManagerAuthorizer)
+
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
+	blobInventoryPoliciesClient := storage.NewBlobInventoryPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobInventoryPoliciesClient.Client, options.ResourceManagerAuthorizer)
+
 	cloudEndpointsClient := storagesync.NewCloudEndpointsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&cloudEndpointsClient.Client, options.ResourceManagerAuthorizer)
 
 	encryptionScopesClient := storage.NewEncryptionScopesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&encryptionScopesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -71,17 +87,21 @@ func NewClient(options *common.ClientOptions) *Client {
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
-		AccountsClient:           &accountsClient,
-		FileSystemsClient:        &fileSystemsClient,
-		ADLSGen2PathsClient:      &adlsGen2PathsClient,
-		ManagementPoliciesClient: &managementPoliciesClient,
-		BlobServicesClient:       &blobServicesClient,
-		CloudEndpointsClient:     &cloudEndpointsClient,
-		EncryptionScopesClient:   &encryptionScopesClient,
-		Environment:              options.Environment,
-		SubscriptionId:           options.SubscriptionId,
-		SyncServiceClient:        &syncServiceClient,
-		SyncGroupsClient:         &syncGroupsClient,
+		AccountsClient:                  &accountsClient,
+		FileSystemsClient:               &fileSystemsClient,
+		ADLSGen2PathsClient:             &adlsGen2PathsClient,
+		ManagementPoliciesClient:        &managementPoliciesClient,
+		ObjectReplicationPoliciesClient: &objectReplicationPoliciesClient,
+		BlobServicesClient:              &blobServicesClient,
+		BlobContainersClient:            &blobContainersClient,
+		BlobInventoryPoliciesClient:     &blobInventoryPoliciesClient,
+		CloudEndpointsClient:            &cloudEndpointsClient,
+		EncryptionScopesClient:          &encryptionScopesClient,
+		FileServicesClient:              &fileServicesClient,
+		Environment:                     options.Environment,
+		SubscriptionId:                  options.SubscriptionId,
+		SyncServiceClient:               &syncServiceClient,
+		SyncGroupsClient:                &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
 	}
@@ -35,23 +35,28 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_storage_account":                      resourceStorageAccount(),
-		"azurerm_storage_account_customer_managed_key": resourceStorageAccountCustomerManagedKey(),
-		"azurerm_storage_account_network_rules":        resourceStorageAccountNetworkRules(),
-		"azurerm_storage_blob":                         resourceStorageBlob(),
-		"azurerm_storage_container":                    resourceStorageContainer(),
-		"azurerm_storage_encryption_scope":             resourceStorageEncryptionScope(),
-		"azurerm_storage_data_lake_gen2_filesystem":    resourceStorageDataLakeGen2FileSystem(),
-		"azurerm_storage_data_lake_gen2_path":          resourceStorageDataLakeGen2Path(),
-		"azurerm_storage_management_policy":            resourceStorageManagementPolicy(),
-		"azurerm_storage_queue":                        resourceStorageQueue(),
-		"azurerm_storage_share":                        resourceStorageShare(),
-		"azurerm_storage_share_file":                   resourceStorageShareFile(),
-		"azurerm_storage_share_directory":              resourceStorageShareDirectory(),
-		"azurerm_storage_table":                        resourceStorageTable(),
-		"azurerm_storage_table_entity":                 resourceStorageTableEntity(),
-		"azurerm_storage_sync":                         resourceStorageSync(),
-		"azurerm_storage_sync_cloud_endpoint":          resourceStorageSyncCloudEndpoint(),
-		"azurerm_storage_sync_group":                   resourceStorageSyncGroup(),
+		"azurerm_storage_account":                       resourceStorageAccount(),
+		"azurerm_storage_account_customer_managed_key":  resourceStorageAccountCustomerManagedKey(),
+		"azurerm_storage_account_network_rules":         resourceStorageAccountNetworkRules(),
+		"azurerm_storage_blob":                          resourceStorageBlob(),
+		"azurerm_storage_blob_directory":                resourceStorageBlobDirectory(),
+		"azurerm_storage_blob_inventory_policy":         resourceStorageBlobInventoryPolicy(),
+		"azurerm_storage_container":                     resourceStorageContainer(),
+		"azurerm_storage_container_immutability_policy": resourceStorageContainerImmutabilityPolicy(),
+		"azurerm_storage_container_legal_hold":          resourceStorageContainerLegalHold(),
+		"azurerm_storage_encryption_scope":              resourceStorageEncryptionScope(),
+		"azurerm_storage_data_lake_gen2_filesystem":     resourceStorageDataLakeGen2FileSystem(),
+		"azurerm_storage_data_lake_gen2_path":           resourceStorageDataLakeGen2Path(),
+		"azurerm_storage_management_policy":             resourceStorageManagementPolicy(),
+		"azurerm_storage_object_replication":            resourceStorageObjectReplication(),
+		"azurerm_storage_queue":                         resourceStorageQueue(),
+		"azurerm_storage_share":                         resourceStorageShare(),
+		"azurerm_storage_share_file":                    resourceStorageShareFile(),
+		"azurerm_storage_share_directory":               resourceStorageShareDirectory(),
+		"azurerm_storage_table":                         resourceStorageTable(),
+		"azurerm_storage_table_entity":                  resourceStorageTableEntity(),
+		"azurerm_storage_sync":                          resourceStorageSync(),
+		"azurerm_storage_sync_cloud_endpoint":           resourceStorageSyncCloudEndpoint(),
+		"azurerm_storage_sync_group":                    resourceStorageSyncGroup(),
 	}
 }
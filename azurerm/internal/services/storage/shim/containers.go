@@ -11,6 +11,7 @@ type StorageContainerWrapper interface {
 	Delete(ctx context.Context, resourceGroup, accountName, containerName string) error
 	Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error)
 	Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error)
+	ListBlobs(ctx context.Context, resourceGroup, accountName, containerName, prefix string) (*[]containers.BlobDetails, error)
 	UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metadata map[string]string) error
 }
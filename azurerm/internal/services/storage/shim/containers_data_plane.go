@@ -88,6 +88,29 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, _, accountNam
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, _, accountName, containerName, prefix string) (*[]containers.BlobDetails, error) {
+	results := make([]containers.BlobDetails, 0)
+
+	input := containers.ListBlobsInput{
+		Prefix: utils.String(prefix),
+	}
+	for {
+		resp, err := w.client.ListBlobs(ctx, accountName, containerName, input)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, resp.Blobs.Blobs...)
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return &results, nil
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, _, accountName, containerName string, level containers.AccessLevel) error {
 	_, err := w.client.SetAccessControl(ctx, accountName, containerName, level)
 	return err
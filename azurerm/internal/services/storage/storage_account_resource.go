@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -282,6 +283,49 @@ func resourceStorageAccount() *schema.Resource {
 								},
 							},
 						},
+						"versioning_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"change_feed_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"change_feed_retention_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+						"last_access_time_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"default_service_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+								"`default_service_version` must be a Storage Service Version in the format `YYYY-MM-DD`",
+							),
+						},
+						"restore_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -383,6 +427,151 @@ func resourceStorageAccount() *schema.Resource {
 				},
 			},
 
+			"share_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": schemaStorageAccountCorsRule(false),
+						"retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+						"smb": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"versions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"SMB2.1",
+												"SMB3.0",
+												"SMB3.1.1",
+											}, false),
+										},
+									},
+									"authentication_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"NTLMv2",
+												"Kerberos",
+											}, false),
+										},
+									},
+									"kerberos_ticket_encryption_type": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"RC4-HMAC",
+												"AES-256",
+											}, false),
+										},
+									},
+									"channel_encryption_type": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"AES-128-CCM",
+												"AES-128-GCM",
+												"AES-256-GCM",
+											}, false),
+										},
+									},
+									"multichannel_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"azure_files_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.DirectoryServiceOptionsAADDS),
+								string(storage.DirectoryServiceOptionsAD),
+							}, false),
+						},
+						"active_directory": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"storage_sid": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"domain_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"domain_sid": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"domain_guid": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+									"forest_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"netbios_domain_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"static_website": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -603,6 +792,14 @@ func resourceStorageAccount() *schema.Resource {
 				}
 			}
 
+			if err := validateStorageAccountBlobProperties(d.Get("blob_properties").([]interface{})); err != nil {
+				return err
+			}
+
+			if err := validateStorageAccountShareProperties(d.Get("share_properties").([]interface{}), d.Get("account_kind").(string), d.Get("account_tier").(string)); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -730,6 +927,14 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("azure_files_authentication"); ok {
+		authentication, err := expandStorageAccountAzureFilesAuthentication(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+		parameters.AzureFilesIdentityBasedAuthentication = authentication
+	}
+
 	// nolint staticcheck
 	if v, ok := d.GetOkExists("large_file_share_enabled"); ok {
 		parameters.LargeFileSharesState = storage.LargeFileSharesStateDisabled
@@ -767,7 +972,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 
 			blobProperties := expandBlobProperties(val.([]interface{}))
 
-			if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			if err = setBlobServiceProperties(ctx, blobClient, resourceGroupName, storageAccountName, blobProperties, false); err != nil {
 				return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
 			}
 		} else {
@@ -800,6 +1005,19 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if val, ok := d.GetOk("share_properties"); ok {
+		if err := validateStorageAccountShareProperties(val.([]interface{}), accountKind, accountTier); err != nil {
+			return err
+		}
+
+		fileServiceClient := meta.(*clients.Client).Storage.FileServicesClient
+		shareProperties := expandShareProperties(val.([]interface{}), accountKind == string(storage.FileStorage))
+
+		if _, err = fileServiceClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, shareProperties); err != nil {
+			return fmt.Errorf("updating Azure Storage Account `share_properties` %q: %+v", storageAccountName, err)
+		}
+	}
+
 	if val, ok := d.GetOk("static_website"); ok {
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
@@ -1003,6 +1221,22 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("azure_files_authentication") {
+		authentication, err := expandStorageAccountAzureFilesAuthentication(d.Get("azure_files_authentication").([]interface{}))
+		if err != nil {
+			return err
+		}
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				AzureFilesIdentityBasedAuthentication: authentication,
+			},
+		}
+
+		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account `azure_files_authentication` %q: %+v", storageAccountName, err)
+		}
+	}
+
 	if d.HasChange("large_file_share_enabled") {
 		isEnabled := storage.LargeFileSharesStateDisabled
 		if v := d.Get("large_file_share_enabled").(bool); v {
@@ -1025,7 +1259,13 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 			blobClient := meta.(*clients.Client).Storage.BlobServicesClient
 			blobProperties := expandBlobProperties(d.Get("blob_properties").([]interface{}))
 
-			if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			restorePolicyWasEnabled := false
+			oldBlobProperties, _ := d.GetChange("blob_properties")
+			if v := oldBlobProperties.([]interface{}); len(v) > 0 && v[0] != nil {
+				restorePolicyWasEnabled = len(v[0].(map[string]interface{})["restore_policy"].([]interface{})) > 0
+			}
+
+			if err = setBlobServiceProperties(ctx, blobClient, resourceGroupName, storageAccountName, blobProperties, restorePolicyWasEnabled); err != nil {
 				return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
 			}
 		} else {
@@ -1058,6 +1298,19 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("share_properties") {
+		if err := validateStorageAccountShareProperties(d.Get("share_properties").([]interface{}), accountKind, accountTier); err != nil {
+			return err
+		}
+
+		fileServiceClient := meta.(*clients.Client).Storage.FileServicesClient
+		shareProperties := expandShareProperties(d.Get("share_properties").([]interface{}), accountKind == string(storage.FileStorage))
+
+		if _, err = fileServiceClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, shareProperties); err != nil {
+			return fmt.Errorf("updating Azure Storage Account `share_properties` %q: %+v", storageAccountName, err)
+		}
+	}
+
 	if d.HasChange("static_website") {
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
@@ -1225,6 +1478,10 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		if props.LargeFileSharesState != "" {
 			d.Set("large_file_share_enabled", props.LargeFileSharesState == storage.LargeFileSharesStateEnabled)
 		}
+
+		if err := d.Set("azure_files_authentication", flattenStorageAccountAzureFilesAuthentication(props.AzureFilesIdentityBasedAuthentication)); err != nil {
+			return fmt.Errorf("setting `azure_files_authentication`: %+v", err)
+		}
 	}
 
 	if accessKeys := keys.Keys; accessKeys != nil {
@@ -1263,11 +1520,27 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	// queue is only available for certain tier and kind (as specified below)
 	if resp.Sku == nil {
 		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): `sku` was nil", name, resGroup)
 	}
 
+	// the File Service is only available for File Storage accounts and Standard General Purpose accounts
+	if storageAccountSupportsShareProperties(string(resp.Kind), string(resp.Sku.Tier)) {
+		fileServiceClient := storageClient.FileServicesClient
+
+		shareProps, err := fileServiceClient.GetServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(shareProps.Response) {
+				return fmt.Errorf("reading share properties for AzureRM Storage Account %q: %+v", name, err)
+			}
+		}
+
+		if err := d.Set("share_properties", flattenShareProperties(shareProps)); err != nil {
+			return fmt.Errorf("setting `share_properties `for AzureRM Storage Account %q: %+v", name, err)
+		}
+	}
+
+	// queue is only available for certain tier and kind (as specified below)
 	if resp.Sku.Tier == storage.Standard {
 		if resp.Kind == storage.Storage || resp.Kind == storage.StorageV2 {
 			queueClient, err := storageClient.QueuesClient(ctx, *account)
@@ -1504,9 +1777,144 @@ func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
 	corsRaw := v["cors_rule"].([]interface{})
 	props.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
 
+	props.BlobServicePropertiesProperties.IsVersioningEnabled = utils.Bool(v["versioning_enabled"].(bool))
+
+	props.BlobServicePropertiesProperties.ChangeFeed = &storage.ChangeFeed{
+		Enabled: utils.Bool(v["change_feed_enabled"].(bool)),
+	}
+	if retentionInDays := v["change_feed_retention_in_days"].(int); retentionInDays != 0 {
+		props.BlobServicePropertiesProperties.ChangeFeed.RetentionInDays = utils.Int32(int32(retentionInDays))
+	}
+
+	props.BlobServicePropertiesProperties.LastAccessTimeTrackingPolicy = &storage.LastAccessTimeTrackingPolicy{
+		Enable: utils.Bool(v["last_access_time_enabled"].(bool)),
+	}
+
+	if version := v["default_service_version"].(string); version != "" {
+		props.BlobServicePropertiesProperties.DefaultServiceVersion = utils.String(version)
+	}
+
+	restorePolicyRaw := v["restore_policy"].([]interface{})
+	props.BlobServicePropertiesProperties.RestorePolicy = expandBlobPropertiesRestorePolicy(restorePolicyRaw)
+
 	return props
 }
 
+func expandBlobPropertiesRestorePolicy(input []interface{}) *storage.RestorePolicyProperties {
+	restorePolicy := storage.RestorePolicyProperties{
+		Enabled: utils.Bool(false),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &restorePolicy
+	}
+
+	policy := input[0].(map[string]interface{})
+	restorePolicy.Enabled = utils.Bool(true)
+	restorePolicy.Days = utils.Int32(int32(policy["days"].(int)))
+
+	return &restorePolicy
+}
+
+// setBlobServiceProperties updates the Blob Service Properties, taking into account that the Restore Policy
+// depends on Versioning, the Change Feed and the Delete Retention Policy - as such it has to be disabled before
+// any of these are disabled and can only be enabled once these have been enabled.
+func setBlobServiceProperties(ctx context.Context, client *storage.BlobServicesClient, resourceGroup, accountName string, input storage.BlobServiceProperties, restorePolicyWasEnabled bool) error {
+	restorePolicy := input.BlobServicePropertiesProperties.RestorePolicy
+	restorePolicyEnabled := restorePolicy != nil && restorePolicy.Enabled != nil && *restorePolicy.Enabled
+
+	if restorePolicyWasEnabled && !restorePolicyEnabled {
+		disableRestorePolicy := storage.BlobServiceProperties{
+			BlobServicePropertiesProperties: &storage.BlobServicePropertiesProperties{
+				RestorePolicy: restorePolicy,
+			},
+		}
+		if _, err := client.SetServiceProperties(ctx, resourceGroup, accountName, disableRestorePolicy); err != nil {
+			return fmt.Errorf("disabling the Restore Policy: %+v", err)
+		}
+	}
+
+	if restorePolicyEnabled {
+		input.BlobServicePropertiesProperties.RestorePolicy = nil
+		if _, err := client.SetServiceProperties(ctx, resourceGroup, accountName, input); err != nil {
+			return fmt.Errorf("updating the dependencies of the Restore Policy: %+v", err)
+		}
+		input.BlobServicePropertiesProperties.RestorePolicy = restorePolicy
+	}
+
+	if _, err := client.SetServiceProperties(ctx, resourceGroup, accountName, input); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// storageAccountSupportsShareProperties returns whether the File Service is available for a Storage Account, which isn't
+// the case for Blob Storage / Block Blob Storage accounts or Premium General Purpose accounts
+func storageAccountSupportsShareProperties(accountKind string, accountTier string) bool {
+	if accountKind == string(storage.BlobStorage) || accountKind == string(storage.BlockBlobStorage) {
+		return false
+	}
+
+	return accountKind == string(storage.FileStorage) || accountTier == string(storage.Standard)
+}
+
+func validateStorageAccountShareProperties(input []interface{}, accountKind string, accountTier string) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	if !storageAccountSupportsShareProperties(accountKind, accountTier) {
+		return fmt.Errorf("`share_properties` aren't supported for Blob Storage / Block Blob Storage accounts or Premium General Purpose accounts")
+	}
+
+	v := input[0].(map[string]interface{})
+	smbRaw := v["smb"].([]interface{})
+	if len(smbRaw) == 0 || smbRaw[0] == nil {
+		return nil
+	}
+
+	// Multichannel is only supported on Premium FileStorage accounts
+	if smbRaw[0].(map[string]interface{})["multichannel_enabled"].(bool) && accountKind != string(storage.FileStorage) {
+		return fmt.Errorf("`smb.0.multichannel_enabled` can only be enabled for File Storage accounts")
+	}
+
+	return nil
+}
+
+func validateStorageAccountBlobProperties(input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	restorePolicyRaw := v["restore_policy"].([]interface{})
+	if len(restorePolicyRaw) == 0 || restorePolicyRaw[0] == nil {
+		return nil
+	}
+
+	if !v["versioning_enabled"].(bool) {
+		return fmt.Errorf("`versioning_enabled` must be `true` when `restore_policy` is specified")
+	}
+
+	if !v["change_feed_enabled"].(bool) {
+		return fmt.Errorf("`change_feed_enabled` must be `true` when `restore_policy` is specified")
+	}
+
+	deletePolicyRaw := v["delete_retention_policy"].([]interface{})
+	if len(deletePolicyRaw) == 0 || deletePolicyRaw[0] == nil {
+		return fmt.Errorf("`delete_retention_policy` must be specified when `restore_policy` is specified")
+	}
+
+	restoreDays := restorePolicyRaw[0].(map[string]interface{})["days"].(int)
+	deleteDays := deletePolicyRaw[0].(map[string]interface{})["days"].(int)
+	if restoreDays >= deleteDays {
+		return fmt.Errorf("`restore_policy.0.days` (%d) must be less than `delete_retention_policy.0.days` (%d)", restoreDays, deleteDays)
+	}
+
+	return nil
+}
+
 func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}) *storage.DeleteRetentionPolicy {
 	deleteRetentionPolicy := storage.DeleteRetentionPolicy{
 		Enabled: utils.Bool(false),
@@ -1556,6 +1964,100 @@ func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
 	return &blobCorsRules
 }
 
+func expandShareProperties(input []interface{}, supportsMultichannel bool) storage.FileServiceProperties {
+	props := storage.FileServiceProperties{
+		FileServicePropertiesProperties: &storage.FileServicePropertiesProperties{
+			Cors: &storage.CorsRules{
+				CorsRules: &[]storage.CorsRule{},
+			},
+			ShareDeleteRetentionPolicy: &storage.DeleteRetentionPolicy{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return props
+	}
+
+	v := input[0].(map[string]interface{})
+
+	props.FileServicePropertiesProperties.ShareDeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy(v["retention_policy"].([]interface{}))
+	props.FileServicePropertiesProperties.Cors = expandBlobPropertiesCors(v["cors_rule"].([]interface{}))
+	props.FileServicePropertiesProperties.ProtocolSettings = &storage.ProtocolSettings{
+		Smb: expandSharePropertiesSMB(v["smb"].([]interface{}), supportsMultichannel),
+	}
+
+	return props
+}
+
+func expandSharePropertiesSMB(input []interface{}, supportsMultichannel bool) *storage.SmbSetting {
+	// the API expects an empty string rather than a null value to reset these to the default
+	smb := storage.SmbSetting{
+		Versions:                 utils.String(""),
+		AuthenticationMethods:    utils.String(""),
+		KerberosTicketEncryption: utils.String(""),
+		ChannelEncryption:        utils.String(""),
+	}
+
+	// Multichannel is only supported on Premium FileStorage accounts
+	if supportsMultichannel {
+		smb.Multichannel = &storage.Multichannel{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &smb
+	}
+
+	v := input[0].(map[string]interface{})
+
+	smb.Versions = utils.String(strings.Join(*utils.ExpandStringSlice(v["versions"].(*schema.Set).List()), ";"))
+	smb.AuthenticationMethods = utils.String(strings.Join(*utils.ExpandStringSlice(v["authentication_types"].(*schema.Set).List()), ";"))
+	smb.KerberosTicketEncryption = utils.String(strings.Join(*utils.ExpandStringSlice(v["kerberos_ticket_encryption_type"].(*schema.Set).List()), ";"))
+	smb.ChannelEncryption = utils.String(strings.Join(*utils.ExpandStringSlice(v["channel_encryption_type"].(*schema.Set).List()), ";"))
+	if smb.Multichannel != nil {
+		smb.Multichannel.Enabled = utils.Bool(v["multichannel_enabled"].(bool))
+	}
+
+	return &smb
+}
+
+func expandStorageAccountAzureFilesAuthentication(input []interface{}) (*storage.AzureFilesIdentityBasedAuthentication, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.AzureFilesIdentityBasedAuthentication{
+			DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+		}, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	directoryOption := storage.DirectoryServiceOptions(v["directory_type"].(string))
+	activeDirectoryRaw := v["active_directory"].([]interface{})
+	if directoryOption == storage.DirectoryServiceOptionsAD && (len(activeDirectoryRaw) == 0 || activeDirectoryRaw[0] == nil) {
+		return nil, fmt.Errorf("`active_directory` is required when `directory_type` is `AD`")
+	}
+
+	authentication := storage.AzureFilesIdentityBasedAuthentication{
+		DirectoryServiceOptions: directoryOption,
+	}
+
+	if directoryOption == storage.DirectoryServiceOptionsAD {
+		ad := activeDirectoryRaw[0].(map[string]interface{})
+		authentication.ActiveDirectoryProperties = &storage.ActiveDirectoryProperties{
+			AzureStorageSid:   utils.String(ad["storage_sid"].(string)),
+			DomainGUID:        utils.String(ad["domain_guid"].(string)),
+			DomainName:        utils.String(ad["domain_name"].(string)),
+			DomainSid:         utils.String(ad["domain_sid"].(string)),
+			ForestName:        utils.String(ad["forest_name"].(string)),
+			NetBiosDomainName: utils.String(ad["netbios_domain_name"].(string)),
+		}
+	}
+
+	return &authentication, nil
+}
+
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
 	var err error
 	properties := queues.StorageServiceProperties{
@@ -1772,7 +2274,36 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		flattenedContainerDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(containerDeletePolicy)
 	}
 
-	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 && len(flattenedContainerDeletePolicy) == 0 {
+	versioningEnabled := false
+	if input.BlobServicePropertiesProperties.IsVersioningEnabled != nil {
+		versioningEnabled = *input.BlobServicePropertiesProperties.IsVersioningEnabled
+	}
+
+	changeFeedEnabled := false
+	changeFeedRetentionInDays := 0
+	if changeFeed := input.BlobServicePropertiesProperties.ChangeFeed; changeFeed != nil {
+		if changeFeed.Enabled != nil {
+			changeFeedEnabled = *changeFeed.Enabled
+		}
+		if changeFeed.RetentionInDays != nil {
+			changeFeedRetentionInDays = int(*changeFeed.RetentionInDays)
+		}
+	}
+
+	lastAccessTimeEnabled := false
+	if policy := input.BlobServicePropertiesProperties.LastAccessTimeTrackingPolicy; policy != nil && policy.Enable != nil {
+		lastAccessTimeEnabled = *policy.Enable
+	}
+
+	defaultServiceVersion := ""
+	if input.BlobServicePropertiesProperties.DefaultServiceVersion != nil {
+		defaultServiceVersion = *input.BlobServicePropertiesProperties.DefaultServiceVersion
+	}
+
+	flattenedRestorePolicy := flattenBlobPropertiesRestorePolicy(input.BlobServicePropertiesProperties.RestorePolicy)
+
+	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 && len(flattenedContainerDeletePolicy) == 0 &&
+		!versioningEnabled && !changeFeedEnabled && !lastAccessTimeEnabled && defaultServiceVersion == "" && len(flattenedRestorePolicy) == 0 {
 		return []interface{}{}
 	}
 
@@ -1781,10 +2312,37 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 			"cors_rule":                         flattenedCorsRules,
 			"delete_retention_policy":           flattenedDeletePolicy,
 			"container_delete_retention_policy": flattenedContainerDeletePolicy,
+			"versioning_enabled":                versioningEnabled,
+			"change_feed_enabled":               changeFeedEnabled,
+			"change_feed_retention_in_days":     changeFeedRetentionInDays,
+			"last_access_time_enabled":          lastAccessTimeEnabled,
+			"default_service_version":           defaultServiceVersion,
+			"restore_policy":                    flattenedRestorePolicy,
 		},
 	}
 }
 
+func flattenBlobPropertiesRestorePolicy(input *storage.RestorePolicyProperties) []interface{} {
+	restorePolicy := make([]interface{}, 0)
+
+	if input == nil {
+		return restorePolicy
+	}
+
+	if enabled := input.Enabled; enabled != nil && *enabled {
+		days := 0
+		if input.Days != nil {
+			days = int(*input.Days)
+		}
+
+		restorePolicy = append(restorePolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return restorePolicy
+}
+
 func flattenBlobPropertiesCorsRule(input *storage.CorsRules) []interface{} {
 	corsRules := make([]interface{}, 0)
 
@@ -1851,6 +2409,133 @@ func flattenBlobPropertiesDeleteRetentionPolicy(input *storage.DeleteRetentionPo
 	return deleteRetentionPolicy
 }
 
+func flattenShareProperties(input storage.FileServiceProperties) []interface{} {
+	if input.FileServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	flattenedCorsRules := make([]interface{}, 0)
+	if corsRules := input.FileServicePropertiesProperties.Cors; corsRules != nil {
+		flattenedCorsRules = flattenBlobPropertiesCorsRule(corsRules)
+	}
+
+	flattenedRetentionPolicy := make([]interface{}, 0)
+	if retentionPolicy := input.FileServicePropertiesProperties.ShareDeleteRetentionPolicy; retentionPolicy != nil {
+		flattenedRetentionPolicy = flattenBlobPropertiesDeleteRetentionPolicy(retentionPolicy)
+	}
+
+	flattenedSMB := make([]interface{}, 0)
+	if protocol := input.FileServicePropertiesProperties.ProtocolSettings; protocol != nil {
+		flattenedSMB = flattenSharePropertiesSMB(protocol.Smb)
+	}
+
+	if len(flattenedCorsRules) == 0 && len(flattenedRetentionPolicy) == 0 && len(flattenedSMB) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":        flattenedCorsRules,
+			"retention_policy": flattenedRetentionPolicy,
+			"smb":              flattenedSMB,
+		},
+	}
+}
+
+func flattenSharePropertiesSMB(input *storage.SmbSetting) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	versions := flattenStorageAccountDelimitedString(input.Versions)
+	authenticationTypes := flattenStorageAccountDelimitedString(input.AuthenticationMethods)
+	kerberosTicketEncryption := flattenStorageAccountDelimitedString(input.KerberosTicketEncryption)
+	channelEncryption := flattenStorageAccountDelimitedString(input.ChannelEncryption)
+
+	multichannelEnabled := false
+	if input.Multichannel != nil && input.Multichannel.Enabled != nil {
+		multichannelEnabled = *input.Multichannel.Enabled
+	}
+
+	if len(versions) == 0 && len(authenticationTypes) == 0 && len(kerberosTicketEncryption) == 0 && len(channelEncryption) == 0 && !multichannelEnabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"versions":                        versions,
+			"authentication_types":            authenticationTypes,
+			"kerberos_ticket_encryption_type": kerberosTicketEncryption,
+			"channel_encryption_type":         channelEncryption,
+			"multichannel_enabled":            multichannelEnabled,
+		},
+	}
+}
+
+func flattenStorageAccountDelimitedString(input *string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range strings.Split(*input, ";") {
+		if v != "" {
+			results = append(results, v)
+		}
+	}
+	return results
+}
+
+func flattenStorageAccountAzureFilesAuthentication(input *storage.AzureFilesIdentityBasedAuthentication) []interface{} {
+	if input == nil || input.DirectoryServiceOptions == storage.DirectoryServiceOptionsNone || input.DirectoryServiceOptions == "" {
+		return []interface{}{}
+	}
+
+	activeDirectory := make([]interface{}, 0)
+	if ad := input.ActiveDirectoryProperties; ad != nil {
+		storageSid := ""
+		if ad.AzureStorageSid != nil {
+			storageSid = *ad.AzureStorageSid
+		}
+		domainGuid := ""
+		if ad.DomainGUID != nil {
+			domainGuid = *ad.DomainGUID
+		}
+		domainName := ""
+		if ad.DomainName != nil {
+			domainName = *ad.DomainName
+		}
+		domainSid := ""
+		if ad.DomainSid != nil {
+			domainSid = *ad.DomainSid
+		}
+		forestName := ""
+		if ad.ForestName != nil {
+			forestName = *ad.ForestName
+		}
+		netBiosDomainName := ""
+		if ad.NetBiosDomainName != nil {
+			netBiosDomainName = *ad.NetBiosDomainName
+		}
+
+		activeDirectory = append(activeDirectory, map[string]interface{}{
+			"storage_sid":         storageSid,
+			"domain_guid":         domainGuid,
+			"domain_name":         domainName,
+			"domain_sid":          domainSid,
+			"forest_name":         forestName,
+			"netbios_domain_name": netBiosDomainName,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"directory_type":   string(input.DirectoryServiceOptions),
+			"active_directory": activeDirectory,
+		},
+	}
+}
+
 func flattenQueueProperties(input *queues.StorageServiceProperties) []interface{} {
 	if input == nil {
 		return []interface{}{}
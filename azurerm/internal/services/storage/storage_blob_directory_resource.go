@@ -0,0 +1,362 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+func resourceStorageBlobDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageBlobDirectoryCreate,
+		Read:   resourceStorageBlobDirectoryRead,
+		Update: resourceStorageBlobDirectoryUpdate,
+		Delete: resourceStorageBlobDirectoryDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			parsed, err := blobs.ParseResourceID(id)
+			if err != nil {
+				return err
+			}
+			if parsed.ContainerName == "" {
+				return fmt.Errorf("parsing %q: the Container Name was empty", id)
+			}
+			if parsed.BlobName == "" {
+				return fmt.Errorf("parsing %q: the Prefix was empty", id)
+			}
+			return nil
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateStorageAccountName,
+			},
+
+			"storage_container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerName,
+			},
+
+			// this resource takes ownership of every Blob beneath the prefix, so an empty prefix (which would
+			// take over the whole Storage Container) isn't allowed
+			"prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[^/]+(/[^/]+)*$`),
+					"`prefix` cannot begin or end with a `/`, or contain empty path segments.",
+				),
+			},
+
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		// the local directory is hashed at plan time so that the plan shows which files will be uploaded or removed
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			if !d.NewValueKnown("source") {
+				if err := d.SetNewComputed("files"); err != nil {
+					return err
+				}
+				return d.SetNewComputed("manifest_hash")
+			}
+
+			source := d.Get("source").(string)
+			manifest, err := buildBlobDirectoryManifest(source)
+			if err != nil {
+				return err
+			}
+			if len(manifest) == 0 {
+				return fmt.Errorf("the source directory %q must contain at least one file", source)
+			}
+
+			files := make(map[string]string)
+			for name, file := range manifest {
+				files[name] = file.ContentMD5
+			}
+
+			existing := make(map[string]string)
+			for name, contentMD5 := range d.Get("files").(map[string]interface{}) {
+				existing[name] = contentMD5.(string)
+			}
+
+			if d.Id() != "" && reflect.DeepEqual(existing, files) {
+				return nil
+			}
+
+			if err := d.SetNew("files", flattenBlobDirectoryFiles(files)); err != nil {
+				return err
+			}
+			return d.SetNew("manifest_hash", blobDirectoryManifestHash(files))
+		},
+	}
+}
+
+func resourceStorageBlobDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blob Directory %q (Container %q): %s", accountName, prefix, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	containersClient, err := storageClient.ContainersClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	id := blobsClient.GetResourceID(accountName, containerName, prefix)
+
+	// this resource takes ownership of every Blob beneath the prefix, so it must be empty to begin with
+	existing, err := listBlobDirectory(ctx, containersClient, account.ResourceGroup, accountName, containerName, prefix)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing Blob Directory %q (Container %q / Account %q): %s", prefix, containerName, accountName, err)
+	}
+	if len(existing) > 0 {
+		return tf.ImportAsExistsError("azurerm_storage_blob_directory", id)
+	}
+
+	log.Printf("[DEBUG] Uploading Blob Directory %q to Container %q within Storage Account %q..", prefix, containerName, accountName)
+	input := BlobDirectorySync{
+		BlobsClient:      blobsClient,
+		ContainersClient: containersClient,
+
+		AccountName:   accountName,
+		ContainerName: containerName,
+		ResourceGroup: account.ResourceGroup,
+
+		Parallelism: d.Get("parallelism").(int),
+		Prefix:      prefix,
+		Source:      d.Get("source").(string),
+	}
+	if err := input.Sync(ctx); err != nil {
+		return fmt.Errorf("uploading Blob Directory %q (Container %q / Account %q): %s", prefix, containerName, accountName, err)
+	}
+	log.Printf("[DEBUG] Uploaded Blob Directory %q to Container %q within Storage Account %q.", prefix, containerName, accountName)
+
+	d.SetId(id)
+
+	return resourceStorageBlobDirectoryRead(d, meta)
+}
+
+func resourceStorageBlobDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := blobs.ParseResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing %q: %s", d.Id(), err)
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blob Directory %q (Container %q): %s", id.AccountName, id.BlobName, id.ContainerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	containersClient, err := storageClient.ContainersClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Synchronising Blob Directory %q in Container %q within Storage Account %q..", id.BlobName, id.ContainerName, id.AccountName)
+	input := BlobDirectorySync{
+		BlobsClient:      blobsClient,
+		ContainersClient: containersClient,
+
+		AccountName:   id.AccountName,
+		ContainerName: id.ContainerName,
+		ResourceGroup: account.ResourceGroup,
+
+		Parallelism: d.Get("parallelism").(int),
+		Prefix:      id.BlobName,
+		Source:      d.Get("source").(string),
+	}
+	if err := input.Sync(ctx); err != nil {
+		return fmt.Errorf("synchronising Blob Directory %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+	log.Printf("[DEBUG] Synchronised Blob Directory %q in Container %q within Storage Account %q.", id.BlobName, id.ContainerName, id.AccountName)
+
+	return resourceStorageBlobDirectoryRead(d, meta)
+}
+
+func resourceStorageBlobDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := blobs.ParseResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing %q: %s", d.Id(), err)
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blob Directory %q (Container %q): %s", id.AccountName, id.BlobName, id.ContainerName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Blob Directory %q (Container %q) - assuming removed & removing from state!", id.AccountName, id.BlobName, id.ContainerName)
+		d.SetId("")
+		return nil
+	}
+
+	containersClient, err := storageClient.ContainersClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	container, err := containersClient.Get(ctx, account.ResourceGroup, id.AccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving Container %q (Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, account.ResourceGroup, err)
+	}
+	if container == nil {
+		log.Printf("[DEBUG] Container %q was not found in Account %q / Resource Group %q - assuming removed & removing from state", id.ContainerName, id.AccountName, account.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	files, err := listBlobDirectory(ctx, containersClient, account.ResourceGroup, id.AccountName, id.ContainerName, id.BlobName)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		log.Printf("[INFO] No Blobs were found beneath Blob Directory %q in Container %q / Account %q - assuming removed & removing from state...", id.BlobName, id.ContainerName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("storage_container_name", id.ContainerName)
+	d.Set("prefix", id.BlobName)
+
+	if err := d.Set("files", flattenBlobDirectoryFiles(files)); err != nil {
+		return fmt.Errorf("setting `files`: %+v", err)
+	}
+	d.Set("manifest_hash", blobDirectoryManifestHash(files))
+
+	return nil
+}
+
+func resourceStorageBlobDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := blobs.ParseResourceID(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing %q: %s", d.Id(), err)
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blob Directory %q (Container %q): %s", id.AccountName, id.BlobName, id.ContainerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	containersClient, err := storageClient.ContainersClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	files, err := listBlobDirectory(ctx, containersClient, account.ResourceGroup, id.AccountName, id.ContainerName, id.BlobName)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting %d Blobs beneath Blob Directory %q from Container %q / Storage Account %q", len(files), id.BlobName, id.ContainerName, id.AccountName)
+	for name := range files {
+		blobName := blobDirectoryBlobName(id.BlobName, name)
+		input := blobs.DeleteInput{
+			DeleteSnapshots: true,
+		}
+		if _, err := blobsClient.Delete(ctx, id.AccountName, id.ContainerName, blobName, input); err != nil {
+			return fmt.Errorf("deleting Blob %q (Container %q / Account %q): %s", blobName, id.ContainerName, id.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenBlobDirectoryFiles(input map[string]string) map[string]interface{} {
+	results := make(map[string]interface{})
+	for name, contentMD5 := range input {
+		results[name] = contentMD5
+	}
+	return results
+}
@@ -0,0 +1,239 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+func TestAccStorageBlobDirectory_basic(t *testing.T) {
+	source := createBlobDirectorySource(t)
+	defer os.RemoveAll(source)
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, source, "content"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("manifest_hash").Exists(),
+			),
+		},
+		data.ImportStep("parallelism", "source"),
+	})
+}
+
+func TestAccStorageBlobDirectory_prefix(t *testing.T) {
+	source := createBlobDirectorySource(t)
+	defer os.RemoveAll(source)
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, source, "site/v1"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("prefix").HasValue("site/v1"),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("parallelism", "source"),
+	})
+}
+
+func TestAccStorageBlobDirectory_requiresImport(t *testing.T) {
+	source := createBlobDirectorySource(t)
+	defer os.RemoveAll(source)
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, source, "content"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, source)
+		}),
+	})
+}
+
+func TestAccStorageBlobDirectory_update(t *testing.T) {
+	source := createBlobDirectorySource(t)
+	defer os.RemoveAll(source)
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, source, "content"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("parallelism", "source"),
+		{
+			PreConfig: func() {
+				// change a file, add a file and remove a file
+				writeBlobDirectorySourceFile(t, source, "index.html", "<html><body>updated</body></html>")
+				writeBlobDirectorySourceFile(t, source, "css/print.css", "body { color: black; }")
+				if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+					t.Fatalf("removing source file: %+v", err)
+				}
+			},
+			Config: r.basic(data, source, "content"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.css/print.css").Exists(),
+				check.That(data.ResourceName).Key("files.css/site.css").DoesNotExist(),
+			),
+		},
+		data.ImportStep("parallelism", "source"),
+	})
+}
+
+func TestAccStorageBlobDirectory_emptySource(t *testing.T) {
+	source, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("creating source directory: %+v", err)
+	}
+	defer os.RemoveAll(source)
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.basic(data, source, "content"),
+			ExpectError: regexp.MustCompile("must contain at least one file"),
+		},
+	})
+}
+
+func (r StorageBlobDirectoryResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := blobs.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Blob Directory %q (Container %q): %+v", id.AccountName, id.BlobName, id.ContainerName, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	containersClient, err := client.Storage.ContainersClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	blobList, err := containersClient.ListBlobs(ctx, account.ResourceGroup, id.AccountName, id.ContainerName, id.BlobName+"/")
+	if err != nil {
+		return nil, fmt.Errorf("listing Blobs beneath Blob Directory %q (Container %q / Account %q): %+v", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+
+	return utils.Bool(len(*blobList) > 0), nil
+}
+
+func (r StorageBlobDirectoryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "site"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageBlobDirectoryResource) basic(data acceptance.TestData, source, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  prefix                 = "%s"
+  source                 = "%s"
+}
+`, r.template(data), prefix, filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectoryResource) requiresImport(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "import" {
+  storage_account_name   = azurerm_storage_blob_directory.test.storage_account_name
+  storage_container_name = azurerm_storage_blob_directory.test.storage_container_name
+  prefix                 = azurerm_storage_blob_directory.test.prefix
+  source                 = azurerm_storage_blob_directory.test.source
+}
+`, r.basic(data, source, "content"))
+}
+
+func createBlobDirectorySource(t *testing.T) string {
+	source, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("creating source directory: %+v", err)
+	}
+
+	writeBlobDirectorySourceFile(t, source, "index.html", "<html><body>hello world</body></html>")
+	writeBlobDirectorySourceFile(t, source, "css/site.css", "body { color: red; }")
+	writeBlobDirectorySourceFile(t, source, "js/app.js", "console.log('hello world');")
+
+	return source
+}
+
+func writeBlobDirectorySourceFile(t *testing.T, source, name, contents string) {
+	path := filepath.Join(source, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", name, err)
+	}
+
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", name, err)
+	}
+}
@@ -0,0 +1,312 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageBlobInventoryPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageBlobInventoryPolicyCreateUpdate,
+		Read:   resourceStorageBlobInventoryPolicyRead,
+		Update: resourceStorageBlobInventoryPolicyCreateUpdate,
+		Delete: resourceStorageBlobInventoryPolicyDelete,
+
+		// the Blob Inventory Policy is a singleton on the Storage Account, so it's identified by the Storage Account ID
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"storage_container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageContainerName,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[a-zA-Z0-9]+$`),
+								"A rule name can contain any combination of alpha numeric characters.",
+							),
+						},
+
+						"filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"blockBlob",
+												"appendBlob",
+												"pageBlob",
+											}, false),
+										},
+									},
+
+									"include_blob_versions": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"include_snapshots": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageBlobInventoryPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobInventoryPoliciesClient
+	containersClient := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Blob Inventory Policy for %s: %+v", *id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_storage_blob_inventory_policy", id.ID())
+		}
+	}
+
+	// the destination container must exist within the same Storage Account, otherwise the API accepts the
+	// policy but no inventory reports are ever generated
+	containerName := d.Get("storage_container_name").(string)
+	container, err := containersClient.Get(ctx, id.ResourceGroup, id.Name, containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(container.Response) {
+			return fmt.Errorf("the destination Container %q was not found in %s", containerName, *id)
+		}
+		return fmt.Errorf("retrieving destination Container %q in %s: %+v", containerName, *id, err)
+	}
+
+	parameters := storage.BlobInventoryPolicy{
+		BlobInventoryPolicyProperties: &storage.BlobInventoryPolicyProperties{
+			Policy: &storage.BlobInventoryPolicySchema{
+				Enabled:     utils.Bool(true),
+				Destination: utils.String(containerName),
+				Type:        utils.String("Inventory"),
+				Rules:       expandBlobInventoryPolicyRules(d.Get("rules").(*schema.Set).List()),
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating Blob Inventory Policy for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageBlobInventoryPolicyRead(d, meta)
+}
+
+func resourceStorageBlobInventoryPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobInventoryPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Blob Inventory Policy for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Blob Inventory Policy for %s: %+v", *id, err)
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	if props := resp.BlobInventoryPolicyProperties; props != nil && props.Policy != nil {
+		d.Set("storage_container_name", props.Policy.Destination)
+
+		if err := d.Set("rules", flattenBlobInventoryPolicyRules(props.Policy.Rules)); err != nil {
+			return fmt.Errorf("setting `rules`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageBlobInventoryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobInventoryPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("deleting Blob Inventory Policy for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandBlobInventoryPolicyRules(input []interface{}) *[]storage.BlobInventoryPolicyRule {
+	results := make([]storage.BlobInventoryPolicyRule, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		results = append(results, storage.BlobInventoryPolicyRule{
+			Enabled: utils.Bool(true),
+			Name:    utils.String(v["name"].(string)),
+			Definition: &storage.BlobInventoryPolicyDefinition{
+				Filters: expandBlobInventoryPolicyFilter(v["filter"].([]interface{})),
+			},
+		})
+	}
+	return &results
+}
+
+func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventoryPolicyFilter {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &storage.BlobInventoryPolicyFilter{
+		PrefixMatch:         utils.ExpandStringSlice(v["prefix_match"].(*schema.Set).List()),
+		BlobTypes:           utils.ExpandStringSlice(v["blob_types"].(*schema.Set).List()),
+		IncludeBlobVersions: utils.Bool(v["include_blob_versions"].(bool)),
+		IncludeSnapshots:    utils.Bool(v["include_snapshots"].(bool)),
+	}
+}
+
+func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		var filter []interface{}
+		if item.Definition != nil {
+			filter = flattenBlobInventoryPolicyFilter(item.Definition.Filters)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":   name,
+			"filter": filter,
+		})
+	}
+	return results
+}
+
+func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	includeBlobVersions := false
+	if input.IncludeBlobVersions != nil {
+		includeBlobVersions = *input.IncludeBlobVersions
+	}
+
+	includeSnapshots := false
+	if input.IncludeSnapshots != nil {
+		includeSnapshots = *input.IncludeSnapshots
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"blob_types":            utils.FlattenStringSlice(input.BlobTypes),
+			"include_blob_versions": includeBlobVersions,
+			"include_snapshots":     includeSnapshots,
+			"prefix_match":          utils.FlattenStringSlice(input.PrefixMatch),
+		},
+	}
+}
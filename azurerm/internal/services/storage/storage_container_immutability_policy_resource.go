@@ -0,0 +1,282 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageContainerImmutabilityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageContainerImmutabilityPolicyCreate,
+		Read:   resourceStorageContainerImmutabilityPolicyRead,
+		Update: resourceStorageContainerImmutabilityPolicyUpdate,
+		Delete: resourceStorageContainerImmutabilityPolicyDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.StorageContainerImmutabilityPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerResourceManagerID,
+			},
+
+			"immutability_period_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 146000),
+			},
+
+			"protected_append_writes_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			// once locked the policy can only be extended - it can't be unlocked, shortened or removed
+			// so we catch these changes at plan time rather than failing part-way through an apply
+			wasLocked, _ := d.GetChange("locked")
+			if !wasLocked.(bool) {
+				return nil
+			}
+
+			if d.HasChange("storage_container_resource_manager_id") {
+				return fmt.Errorf("a locked Immutability Policy cannot be deleted, so `storage_container_resource_manager_id` cannot be changed")
+			}
+
+			if !d.Get("locked").(bool) {
+				return fmt.Errorf("a locked Immutability Policy cannot be unlocked")
+			}
+
+			oldDays, newDays := d.GetChange("immutability_period_in_days")
+			if newDays.(int) < oldDays.(int) {
+				return fmt.Errorf("the `immutability_period_in_days` of a locked Immutability Policy can only be increased")
+			}
+
+			if d.HasChange("protected_append_writes_enabled") {
+				return fmt.Errorf("`protected_append_writes_enabled` cannot be changed once the Immutability Policy is locked")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceStorageContainerImmutabilityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	containerId, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageContainerImmutabilityPolicyID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, "default")
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	// the Immutability Policy API returns an empty policy when none exists, so we check the Container instead
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("the Container for %s was not found", id)
+		}
+		return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	}
+	if props := existing.ContainerProperties; props != nil && props.HasImmutabilityPolicy != nil && *props.HasImmutabilityPolicy {
+		return tf.ImportAsExistsError("azurerm_storage_container_immutability_policy", id.ID())
+	}
+
+	parameters := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+			AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+		},
+	}
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &parameters, "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("locked").(bool) {
+		if resp.Etag == nil {
+			return fmt.Errorf("locking %s: `etag` was nil", id)
+		}
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", id, err)
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil || existing.ImmutabilityPolicyProperty == nil {
+		return fmt.Errorf("retrieving %s: `etag` or `properties` was nil", *id)
+	}
+	etag := *existing.Etag
+
+	parameters := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+		},
+	}
+
+	if existing.ImmutabilityPolicyProperty.State == storage.Locked {
+		// a locked policy can only have its retention period extended
+		if _, err := client.ExtendImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, etag, &parameters); err != nil {
+			return fmt.Errorf("extending %s: %+v", *id, err)
+		}
+
+		return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+	}
+
+	parameters.ImmutabilityPolicyProperty.AllowProtectedAppendWrites = utils.Bool(d.Get("protected_append_writes_enabled").(bool))
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &parameters, etag)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.Get("locked").(bool) {
+		if resp.Etag == nil {
+			return fmt.Errorf("locking %s: `etag` was nil", *id)
+		}
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", *id, err)
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Container for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Container for %s: %+v", *id, err)
+	}
+
+	props := resp.ContainerProperties
+	if props == nil || props.HasImmutabilityPolicy == nil || !*props.HasImmutabilityPolicy || props.ImmutabilityPolicy == nil || props.ImmutabilityPolicy.ImmutabilityPolicyProperty == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+	d.Set("storage_container_resource_manager_id", containerId.ID())
+
+	policy := props.ImmutabilityPolicy.ImmutabilityPolicyProperty
+
+	days := 0
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		days = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+	d.Set("immutability_period_in_days", days)
+
+	protectedAppendWrites := false
+	if policy.AllowProtectedAppendWrites != nil {
+		protectedAppendWrites = *policy.AllowProtectedAppendWrites
+	}
+	d.Set("protected_append_writes_enabled", protectedAppendWrites)
+	d.Set("locked", policy.State == storage.Locked)
+
+	return nil
+}
+
+func resourceStorageContainerImmutabilityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil {
+		return fmt.Errorf("retrieving %s: `etag` was nil", *id)
+	}
+
+	if props := existing.ImmutabilityPolicyProperty; props != nil && props.State == storage.Locked {
+		return fmt.Errorf("%s is locked and cannot be deleted - if this is intentional, remove it from the state using `terraform state rm`", *id)
+	}
+
+	if _, err := client.DeleteImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageContainerLegalHold() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageContainerLegalHoldCreate,
+		Read:   resourceStorageContainerLegalHoldRead,
+		Update: resourceStorageContainerLegalHoldUpdate,
+		Delete: resourceStorageContainerLegalHoldDelete,
+
+		// the Legal Hold is a singleton on the Container, so it's identified by the Container's Resource Manager ID
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.StorageContainerResourceManagerID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerResourceManagerID,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.StorageContainerLegalHoldTag,
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageContainerLegalHoldCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("checking for presence of existing Legal Hold for %s: %+v", *id, err)
+	}
+	if props := existing.ContainerProperties; props != nil && props.HasLegalHold != nil && *props.HasLegalHold {
+		return tf.ImportAsExistsError("azurerm_storage_container_legal_hold", id.ID())
+	}
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("tags").(*schema.Set).List()),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	if d.HasChange("tags") {
+		oldRaw, newRaw := d.GetChange("tags")
+		oldTags := oldRaw.(*schema.Set)
+		newTags := newRaw.(*schema.Set)
+
+		// the tags are added before the removed tags are cleared, since clearing every tag releases the Legal Hold
+		if toAdd := newTags.Difference(oldTags); toAdd.Len() > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(toAdd.List()),
+			}
+			if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+			}
+		}
+
+		if toRemove := oldTags.Difference(newTags); toRemove.Len() > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(toRemove.List()),
+			}
+			if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+			}
+		}
+	}
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.ContainerProperties
+	if props == nil || props.HasLegalHold == nil || !*props.HasLegalHold {
+		log.Printf("[DEBUG] Legal Hold for %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_container_resource_manager_id", id.ID())
+
+	if err := d.Set("tags", flattenStorageContainerLegalHoldTags(props.LegalHold)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageContainerLegalHoldDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("tags").(*schema.Set).List()),
+	}
+	if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenStorageContainerLegalHoldTags(input *storage.LegalHoldProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Tags == nil {
+		return results
+	}
+
+	for _, item := range *input.Tags {
+		if item.Tag != nil {
+			results = append(results, *item.Tag)
+		}
+	}
+	return results
+}
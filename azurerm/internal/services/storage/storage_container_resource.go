@@ -63,7 +63,7 @@ func resourceStorageContainer() *schema.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			// TODO: support for ACL's - Legal Holds and Immutability Policies are managed using their own resources
 			"has_immutability_policy": {
 				Type:     schema.TypeBool,
 				Computed: true,
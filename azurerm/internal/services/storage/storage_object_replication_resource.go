@@ -0,0 +1,434 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	objectReplicationCopyOnlyNewObjects = "OnlyNewObjects"
+	objectReplicationCopyEverything     = "Everything"
+
+	// the API represents "copy everything" as a minimum creation time of the earliest supported date
+	objectReplicationEverythingMinCreationTime = "1601-01-01T00:00:00Z"
+)
+
+func resourceStorageObjectReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageObjectReplicationCreate,
+		Read:   resourceStorageObjectReplicationRead,
+		Update: resourceStorageObjectReplicationUpdate,
+		Delete: resourceStorageObjectReplicationDelete,
+
+		// the Object Replication Policy can be imported using the ID from either the source or the destination
+		// Storage Account, since both sides share the same Policy ID
+		Importer: azSchema.ValidateResourceIDPriorToImportThen(func(id string) error {
+			_, err := parse.ObjectReplicationPolicyID(id)
+			return err
+		}, importStorageObjectReplication),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"destination_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.StorageContainerName,
+						},
+
+						"destination_container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.StorageContainerName,
+						},
+
+						"copy_blobs_created_after": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  objectReplicationCopyOnlyNewObjects,
+							ValidateFunc: validation.Any(
+								validation.IsRFC3339Time,
+								validation.StringInSlice([]string{
+									objectReplicationCopyOnlyNewObjects,
+									objectReplicationCopyEverything,
+								}, false),
+							),
+						},
+
+						"filter_out_blobs_with_prefix": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"source_object_replication_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_object_replication_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageObjectReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	sourceAccountId, err := parse.StorageAccountID(d.Get("source_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	destinationAccountId, err := parse.StorageAccountID(d.Get("destination_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.List(ctx, sourceAccountId.ResourceGroup, sourceAccountId.Name)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing Object Replication Policies for %s: %+v", *sourceAccountId, err)
+	}
+	if existing.Value != nil {
+		for _, policy := range *existing.Value {
+			if policy.ID == nil || policy.ObjectReplicationPolicyProperties == nil || policy.DestinationAccount == nil {
+				continue
+			}
+
+			if strings.EqualFold(*policy.DestinationAccount, destinationAccountId.Name) {
+				return tf.ImportAsExistsError("azurerm_storage_object_replication", *policy.ID)
+			}
+		}
+	}
+
+	parameters := storage.ObjectReplicationPolicy{
+		ObjectReplicationPolicyProperties: &storage.ObjectReplicationPolicyProperties{
+			SourceAccount:      utils.String(sourceAccountId.Name),
+			DestinationAccount: utils.String(destinationAccountId.Name),
+			Rules:              expandObjectReplicationPolicyRules(d.Get("rules").(*schema.Set).List(), nil),
+		},
+	}
+
+	// the Policy has to be created on the destination account first, which generates the Policy ID and
+	// the Rule ID's which then have to be specified when creating the Policy on the source account
+	destinationPolicy, err := client.CreateOrUpdate(ctx, destinationAccountId.ResourceGroup, destinationAccountId.Name, "default", parameters)
+	if err != nil {
+		return fmt.Errorf("creating Object Replication Policy for destination %s: %+v", *destinationAccountId, err)
+	}
+	if destinationPolicy.ObjectReplicationPolicyProperties == nil || destinationPolicy.PolicyID == nil {
+		return fmt.Errorf("creating Object Replication Policy for destination %s: `policyId` was nil", *destinationAccountId)
+	}
+	policyId := *destinationPolicy.PolicyID
+
+	parameters.ObjectReplicationPolicyProperties.Rules = destinationPolicy.Rules
+	if _, err := client.CreateOrUpdate(ctx, sourceAccountId.ResourceGroup, sourceAccountId.Name, policyId, parameters); err != nil {
+		return fmt.Errorf("creating Object Replication Policy %q for source %s: %+v", policyId, *sourceAccountId, err)
+	}
+
+	id := parse.NewObjectReplicationPolicyID(sourceAccountId.SubscriptionId, sourceAccountId.ResourceGroup, sourceAccountId.Name, policyId)
+	d.SetId(id.ID())
+
+	return resourceStorageObjectReplicationRead(d, meta)
+}
+
+func resourceStorageObjectReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	destinationAccountId, err := parse.StorageAccountID(d.Get("destination_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("source_storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+	d.Set("destination_storage_account_id", destinationAccountId.ID())
+	d.Set("source_object_replication_id", id.ID())
+	d.Set("destination_object_replication_id", parse.NewObjectReplicationPolicyID(destinationAccountId.SubscriptionId, destinationAccountId.ResourceGroup, destinationAccountId.Name, id.Name).ID())
+
+	if props := resp.ObjectReplicationPolicyProperties; props != nil {
+		if err := d.Set("rules", flattenObjectReplicationPolicyRules(props.Rules)); err != nil {
+			return fmt.Errorf("setting `rules`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageObjectReplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	destinationAccountId, err := parse.StorageAccountID(d.Get("destination_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, destinationAccountId.ResourceGroup, destinationAccountId.Name, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Object Replication Policy %q for destination %s: %+v", id.Name, *destinationAccountId, err)
+	}
+
+	var existingRules *[]storage.ObjectReplicationPolicyRule
+	if existing.ObjectReplicationPolicyProperties != nil {
+		existingRules = existing.Rules
+	}
+
+	parameters := storage.ObjectReplicationPolicy{
+		ObjectReplicationPolicyProperties: &storage.ObjectReplicationPolicyProperties{
+			SourceAccount:      utils.String(id.StorageAccountName),
+			DestinationAccount: utils.String(destinationAccountId.Name),
+			Rules:              expandObjectReplicationPolicyRules(d.Get("rules").(*schema.Set).List(), existingRules),
+		},
+	}
+
+	destinationPolicy, err := client.CreateOrUpdate(ctx, destinationAccountId.ResourceGroup, destinationAccountId.Name, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating Object Replication Policy %q for destination %s: %+v", id.Name, *destinationAccountId, err)
+	}
+	if destinationPolicy.ObjectReplicationPolicyProperties != nil {
+		parameters.ObjectReplicationPolicyProperties.Rules = destinationPolicy.Rules
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStorageObjectReplicationRead(d, meta)
+}
+
+func resourceStorageObjectReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	destinationAccountId, err := parse.StorageAccountID(d.Get("destination_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	if resp, err := client.Delete(ctx, destinationAccountId.ResourceGroup, destinationAccountId.Name, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Object Replication Policy %q for destination %s: %+v", id.Name, *destinationAccountId, err)
+		}
+	}
+
+	return nil
+}
+
+func importStorageObjectReplication(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*clients.Client).Storage.ObjectReplicationPoliciesClient
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationPolicyID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.Name)
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	props := resp.ObjectReplicationPolicyProperties
+	if props == nil || props.SourceAccount == nil || props.DestinationAccount == nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("retrieving %s: `sourceAccount` or `destinationAccount` was nil", *id)
+	}
+
+	importingSourceSide := strings.EqualFold(*props.SourceAccount, id.StorageAccountName)
+	otherAccountName := *props.SourceAccount
+	if importingSourceSide {
+		otherAccountName = *props.DestinationAccount
+	}
+
+	account, err := storageClient.FindAccount(ctx, otherAccountName)
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("locating Storage Account %q: %+v", otherAccountName, err)
+	}
+	if account == nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("unable to locate Storage Account %q", otherAccountName)
+	}
+	otherAccountId, err := parse.StorageAccountID(account.ID)
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+
+	if importingSourceSide {
+		d.Set("destination_storage_account_id", otherAccountId.ID())
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// the resource is tracked using the ID of the Policy on the source account
+	d.SetId(parse.NewObjectReplicationPolicyID(otherAccountId.SubscriptionId, otherAccountId.ResourceGroup, otherAccountId.Name, id.Name).ID())
+	d.Set("destination_storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandObjectReplicationPolicyRules(input []interface{}, existing *[]storage.ObjectReplicationPolicyRule) *[]storage.ObjectReplicationPolicyRule {
+	results := make([]storage.ObjectReplicationPolicyRule, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		sourceContainerName := v["source_container_name"].(string)
+		destinationContainerName := v["destination_container_name"].(string)
+
+		rule := storage.ObjectReplicationPolicyRule{
+			SourceContainer:      utils.String(sourceContainerName),
+			DestinationContainer: utils.String(destinationContainerName),
+			Filters: &storage.ObjectReplicationPolicyFilter{
+				PrefixMatch:     utils.ExpandStringSlice(v["filter_out_blobs_with_prefix"].(*schema.Set).List()),
+				MinCreationTime: expandObjectReplicationPolicyMinCreationTime(v["copy_blobs_created_after"].(string)),
+			},
+		}
+
+		// rules which already exist must be sent with the Rule ID generated by the destination account
+		if existing != nil {
+			for _, existingRule := range *existing {
+				if existingRule.SourceContainer == nil || existingRule.DestinationContainer == nil {
+					continue
+				}
+
+				if *existingRule.SourceContainer == sourceContainerName && *existingRule.DestinationContainer == destinationContainerName {
+					rule.RuleID = existingRule.RuleID
+					break
+				}
+			}
+		}
+
+		results = append(results, rule)
+	}
+	return &results
+}
+
+func expandObjectReplicationPolicyMinCreationTime(input string) *string {
+	switch input {
+	case objectReplicationCopyOnlyNewObjects:
+		return nil
+	case objectReplicationCopyEverything:
+		return utils.String(objectReplicationEverythingMinCreationTime)
+	default:
+		return utils.String(input)
+	}
+}
+
+func flattenObjectReplicationPolicyRules(input *[]storage.ObjectReplicationPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		sourceContainerName := ""
+		if item.SourceContainer != nil {
+			sourceContainerName = *item.SourceContainer
+		}
+
+		destinationContainerName := ""
+		if item.DestinationContainer != nil {
+			destinationContainerName = *item.DestinationContainer
+		}
+
+		copyBlobsCreatedAfter := objectReplicationCopyOnlyNewObjects
+		var prefixMatch []interface{}
+		if filters := item.Filters; filters != nil {
+			if filters.MinCreationTime != nil && *filters.MinCreationTime != "" {
+				copyBlobsCreatedAfter = *filters.MinCreationTime
+				if copyBlobsCreatedAfter == objectReplicationEverythingMinCreationTime {
+					copyBlobsCreatedAfter = objectReplicationCopyEverything
+				}
+			}
+			prefixMatch = utils.FlattenStringSlice(filters.PrefixMatch)
+		}
+
+		results = append(results, map[string]interface{}{
+			"source_container_name":        sourceContainerName,
+			"destination_container_name":   destinationContainerName,
+			"copy_blobs_created_after":     copyBlobsCreatedAfter,
+			"filter_out_blobs_with_prefix": prefixMatch,
+		})
+	}
+	return results
+}
@@ -0,0 +1,259 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type StorageObjectReplicationResource struct{}
+
+func TestAccStorageObjectReplication_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_object_replication", "test")
+	r := StorageObjectReplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_object_replication_id").Exists(),
+				check.That(data.ResourceName).Key("destination_object_replication_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageObjectReplication_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_object_replication", "test")
+	r := StorageObjectReplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageObjectReplication_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_object_replication", "test")
+	r := StorageObjectReplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rules.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageObjectReplication_importFromDestination(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_object_replication", "test")
+	r := StorageObjectReplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: func(s *terraform.State) (string, error) {
+				rs, ok := s.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+				}
+				return rs.Primary.Attributes["destination_object_replication_id"], nil
+			},
+		},
+	})
+}
+
+func (r StorageObjectReplicationResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ObjectReplicationPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ObjectReplicationPoliciesClient.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	destinationId, err := parse.ObjectReplicationPolicyID(state.Attributes["destination_object_replication_id"])
+	if err != nil {
+		return nil, err
+	}
+
+	destinationResp, err := client.Storage.ObjectReplicationPoliciesClient.Get(ctx, destinationId.ResourceGroup, destinationId.StorageAccountName, destinationId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(destinationResp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *destinationId, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageObjectReplicationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "src" {
+  name     = "acctestRG-storage-src-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "src" {
+  name                     = "stracctsrc%[3]s"
+  resource_group_name      = azurerm_resource_group.src.name
+  location                 = azurerm_resource_group.src.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+  }
+}
+
+resource "azurerm_storage_container" "src" {
+  name                  = "strcsrc%[3]s"
+  storage_account_name  = azurerm_storage_account.src.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_container" "src_second" {
+  name                  = "strcsrcsecond%[3]s"
+  storage_account_name  = azurerm_storage_account.src.name
+  container_access_type = "private"
+}
+
+resource "azurerm_resource_group" "dst" {
+  name     = "acctestRG-storage-dst-%[1]d"
+  location = "%[4]s"
+}
+
+resource "azurerm_storage_account" "dst" {
+  name                     = "stracctdst%[3]s"
+  resource_group_name      = azurerm_resource_group.dst.name
+  location                 = azurerm_resource_group.dst.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+  }
+}
+
+resource "azurerm_storage_container" "dst" {
+  name                  = "strcdst%[3]s"
+  storage_account_name  = azurerm_storage_account.dst.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_container" "dst_second" {
+  name                  = "strcdstsecond%[3]s"
+  storage_account_name  = azurerm_storage_account.dst.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.Locations.Secondary)
+}
+
+func (r StorageObjectReplicationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_object_replication" "test" {
+  source_storage_account_id      = azurerm_storage_account.src.id
+  destination_storage_account_id = azurerm_storage_account.dst.id
+
+  rules {
+    source_container_name      = azurerm_storage_container.src.name
+    destination_container_name = azurerm_storage_container.dst.name
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageObjectReplicationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_object_replication" "import" {
+  source_storage_account_id      = azurerm_storage_object_replication.test.source_storage_account_id
+  destination_storage_account_id = azurerm_storage_object_replication.test.destination_storage_account_id
+
+  rules {
+    source_container_name      = azurerm_storage_container.src.name
+    destination_container_name = azurerm_storage_container.dst.name
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageObjectReplicationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_object_replication" "test" {
+  source_storage_account_id      = azurerm_storage_account.src.id
+  destination_storage_account_id = azurerm_storage_account.dst.id
+
+  rules {
+    source_container_name        = azurerm_storage_container.src.name
+    destination_container_name   = azurerm_storage_container.dst.name
+    copy_blobs_created_after     = "Everything"
+    filter_out_blobs_with_prefix = ["blobA", "blobB"]
+  }
+
+  rules {
+    source_container_name      = azurerm_storage_container.src_second.name
+    destination_container_name = azurerm_storage_container.dst_second.name
+    copy_blobs_created_after   = "2020-08-20T00:00:00Z"
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,17 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageContainerLegalHoldTag(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	// the API normalizes Legal Hold Tags to lower case, so only lower case values are accepted to avoid a diff
+	if !regexp.MustCompile("^[0-9a-z]{3,23}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("legal hold tag %q must be lower case alphanumeric, and between 3 to 23 characters", input))
+	}
+
+	return warnings, errors
+}
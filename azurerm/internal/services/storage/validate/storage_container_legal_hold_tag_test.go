@@ -0,0 +1,32 @@
+package validate
+
+import "testing"
+
+func TestStorageContainerLegalHoldTag(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"abc123", false},
+		{"ABC123", true},
+		{"abc-123", true},
+		{"abc_123", true},
+		{"abcdefghijklmnopqrstuvw", false},
+		{"abcdefghijklmnopqrstuvwx", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageContainerLegalHoldTag(test.input, "tags")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating tag %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating tag %q to pass: %+v", test.input, es)
+		}
+	}
+}